@@ -21,4 +21,30 @@ const (
 	ACCESS_TOKEN_NAME      = "access_token"
 	ACCESS_TOKEN_DURATION  = 5 * time.Minute
 	JWT_ISSUER             = "backend-template"
+
+	// File Upload Rules
+	FILE_DEFAULT_CATEGORY        = "general"
+	FILE_DEFAULT_MAX_SIZE        = 10 << 20 // 10MB
+	FILE_PRESIGNED_URL_DURATION  = 5 * time.Minute
+	FILE_MULTIPART_PART_SIZE     = 10 << 20 // 10MB (S3 minimum 5MB)
+	FILE_MULTIPART_MAX_PARTS     = 10000
+	FILE_MULTIPART_URL_DURATION  = 1 * time.Hour
+	FILE_MULTIPART_UPLOAD_EXPIRY = 24 * time.Hour
+	FILE_PRIVATE_PREFIX          = "private" // Public erişime kapalı nesnelerin kök klasörü
+	FILE_DOWNLOAD_URL_DURATION   = 5 * time.Minute
+	FILE_TRASH_RETENTION         = 30 * 24 * time.Hour // FILE_TRASH_RETENTION_DAYS ile değiştirilebilir
+	FILE_BATCH_WORKERS           = 8                   // Toplu işlemlerde aynı anda çalışan storage işlemi sayısı
+	FILE_PROXY_UPLOAD_MAX_SIZE   = 25 << 20            // 25MB, sunucu üzerinden yüklenebilecek en büyük dosya
+	FILE_SNIFF_LENGTH            = 512                 // İçerik tipi tespiti için okunan baş kısım (http.DetectContentType sınırı)
+	FILE_SCAN_WORKERS            = 4                   // Aynı anda çalışan zararlı yazılım taraması sayısı
+	FILE_SCAN_TIMEOUT            = 5 * time.Minute     // Tek bir dosyanın taranması için üst süre
+	FILE_SCAN_RETRY_DELAY        = 10 * time.Minute    // Bu süreden uzun karantinada kalan dosyalar yeniden taranır
+	FILE_SCAN_MAX_ATTEMPTS       = 5                   // Bu kadar hata veren dosya bir daha denenmez ve scan_failed olur
+	FILE_SCAN_MAX_SIZE           = 25 << 20            // 25MB, clamd StreamMaxLength varsayılanı; FILE_SCAN_MAX_SIZE_MB ile değiştirilebilir
+	FILE_SIGNATURE_RETENTION     = 24 * time.Hour      // Süresi dolmuş ve tamamlanmamış imzaların silinmeden önce saklanma süresi
+
+	// GitHub Rules
+	GITHUB_BRANCH_CACHE_TTL    = 30 * time.Second // Branch son commit'lerinin önbellekte tutulma süresi
+	GITHUB_RATE_LIMIT_RESERVE  = 100              // Kalan istek bu sayının altına inince istekler sıraya alınır
+	GITHUB_RATE_LIMIT_MAX_WAIT = 1 * time.Minute  // Kota dolduğunda bir isteğin sıfırlanmayı bekleyebileceği en uzun süre
 )
@@ -0,0 +1,181 @@
+package configs
+
+import (
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-template/types"
+)
+
+var (
+	imageMimeTypes    = []string{"image/jpeg", "image/png", "image/gif", "image/webp", "image/avif", "image/svg+xml"}
+	imageExtensions   = []string{".jpg", ".jpeg", ".png", ".gif", ".webp", ".avif", ".svg"}
+	documentMimeTypes = []string{
+		"application/pdf",
+		"application/msword",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	}
+	documentExtensions = []string{".pdf", ".doc", ".docx"}
+)
+
+// FileCategoryPolicies, sisteme yüklenebilecek dosya kategorilerini ve her birinin kurallarını tanımlar.
+// Listede olmayan kategoriler reddedilir. Boş kategori FILE_DEFAULT_CATEGORY olarak değerlendirilir.
+var FileCategoryPolicies = map[string]types.FileCategoryPolicy{
+	"general": {
+		Name:              "general",
+		Description:       "Genel amaçlı dosyalar",
+		AllowedMimeTypes:  append(slices.Clone(imageMimeTypes), "application/pdf", "text/plain"),
+		AllowedExtensions: append(slices.Clone(imageExtensions), ".pdf", ".txt"),
+		MaxSize:           FILE_DEFAULT_MAX_SIZE,
+		Visibility:        types.FileVisibilityPublic,
+	},
+	"image": {
+		Name:              "image",
+		Description:       "Site içinde kullanılan görseller",
+		AllowedMimeTypes:  imageMimeTypes,
+		AllowedExtensions: imageExtensions,
+		MaxSize:           10 << 20, // 10MB
+		Visibility:        types.FileVisibilityPublic,
+		ImageProcessing:   true,
+	},
+	"document": {
+		Name:              "document",
+		Description:       "Herkese açık dokümanlar (PDF, Word)",
+		AllowedMimeTypes:  documentMimeTypes,
+		AllowedExtensions: documentExtensions,
+		MaxSize:           200 << 20, // 200MB
+		Visibility:        types.FileVisibilityPublic,
+	},
+	"video": {
+		Name:              "video",
+		Description:       "Video dosyaları",
+		AllowedMimeTypes:  []string{"video/mp4", "video/webm"},
+		AllowedExtensions: []string{".mp4", ".webm"},
+		MaxSize:           2 << 30, // 2GB
+		Visibility:        types.FileVisibilityPublic,
+	},
+	"cv": {
+		Name:               "cv",
+		Description:        "Özgeçmişler",
+		AllowedMimeTypes:   documentMimeTypes,
+		AllowedExtensions:  documentExtensions,
+		MaxSize:            10 << 20, // 10MB
+		Visibility:         types.FileVisibilityPrivate,
+		RetentionDays:      365,
+		RequiredPermission: types.CanManagePrivateFiles,
+	},
+	"cover_letter": {
+		Name:               "cover_letter",
+		Description:        "Ön yazılar",
+		AllowedMimeTypes:   documentMimeTypes,
+		AllowedExtensions:  documentExtensions,
+		MaxSize:            10 << 20, // 10MB
+		Visibility:         types.FileVisibilityPrivate,
+		RetentionDays:      365,
+		RequiredPermission: types.CanManagePrivateFiles,
+	},
+	"certificate": {
+		Name:               "certificate",
+		Description:        "Sertifikalar",
+		AllowedMimeTypes:   append(slices.Clone(documentMimeTypes), "image/jpeg", "image/png"),
+		AllowedExtensions:  append(slices.Clone(documentExtensions), ".jpg", ".jpeg", ".png"),
+		MaxSize:            20 << 20, // 20MB
+		Visibility:         types.FileVisibilityPrivate,
+		RetentionDays:      365,
+		RequiredPermission: types.CanManagePrivateFiles,
+	},
+}
+
+// NormalizeFileCategory, boş veya sadece boşluk içeren kategoriyi varsayılan kategoriye çevirir.
+func NormalizeFileCategory(category string) string {
+	category = strings.TrimSpace(category)
+	if category == "" {
+		return FILE_DEFAULT_CATEGORY
+	}
+	return category
+}
+
+// GetFileCategoryPolicy, verilen kategorinin kurallarını döndürür. Kategori tanımlı değilse false döner.
+func GetFileCategoryPolicy(category string) (types.FileCategoryPolicy, bool) {
+	policy, ok := FileCategoryPolicies[NormalizeFileCategory(category)]
+	return policy, ok
+}
+
+// IsFileTypeAllowed, dosya adı uzantısının ve MIME tipinin kategori kurallarına uyup uymadığını döndürür.
+// MIME tipi listesinde "image/*" gibi joker tanımlar kullanılabilir.
+func IsFileTypeAllowed(policy types.FileCategoryPolicy, filename, contentType string) bool {
+	extension := strings.ToLower(path.Ext(filename))
+	if !slices.Contains(policy.AllowedExtensions, extension) {
+		return false
+	}
+
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	for _, allowed := range policy.AllowedMimeTypes {
+		if allowed == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFileTrashRetention, çöp kutusundaki dosyaların kalıcı olarak silinmeden önce ne kadar saklanacağını döndürür.
+// FILE_TRASH_RETENTION_DAYS ortam değişkeni geçerli bir gün sayısı içeriyorsa o kullanılır.
+func GetFileTrashRetention() time.Duration {
+	if days, err := strconv.Atoi(os.Getenv("FILE_TRASH_RETENTION_DAYS")); err == nil && days > 0 {
+		return time.Duration(days) * 24 * time.Hour
+	}
+	return FILE_TRASH_RETENTION
+}
+
+// GetFileScanMaxSize, zararlı yazılım taramasına gönderilebilecek en büyük dosya boyutunu döndürür.
+// Tarayıcının akış sınırıyla (clamd StreamMaxLength) aynı olmalıdır; daha büyük dosyalar taranmadan scan_failed
+// durumuna alınır. FILE_SCAN_MAX_SIZE_MB ortam değişkeniyle değiştirilebilir, 0 sınırsız anlamına gelir.
+func GetFileScanMaxSize() int64 {
+	if megabytes, err := strconv.ParseInt(os.Getenv("FILE_SCAN_MAX_SIZE_MB"), 10, 64); err == nil && megabytes >= 0 {
+		return megabytes << 20
+	}
+	return FILE_SCAN_MAX_SIZE
+}
+
+// GetFileCDNURLBase, public dosya URL'lerinin üretileceği CDN adresini döndürür.
+// FILE_CDN_URL_BASE tanımlı değilse boş döner ve storage sürücüsünün kendi public adresi kullanılır.
+func GetFileCDNURLBase() string {
+	return strings.TrimRight(strings.TrimSpace(os.Getenv("FILE_CDN_URL_BASE")), "/")
+}
+
+// FileRoleQuotas, rollere göre kullanıcı başına depolama sınırlarıdır. 0 sınırsız anlamına gelir.
+// Toplam sınır FILE_QUOTA_<ROL>_TOTAL_MB ortam değişkeni ile değiştirilebilir (ör. FILE_QUOTA_USER_TOTAL_MB=2048).
+var FileRoleQuotas = map[types.Role]types.FileQuota{
+	types.RoleUser: {
+		TotalBytes: 1 << 30, // 1GB
+		CategoryBytes: map[string]int64{
+			"video":    512 << 20, // 512MB
+			"document": 256 << 20, // 256MB
+		},
+	},
+	types.RoleEditor: {
+		TotalBytes: 50 << 30, // 50GB
+	},
+	types.RoleAdmin: {},
+}
+
+// GetFileQuota, verilen rolün depolama sınırlarını döndürür. Tanımsız roller için User sınırları kullanılır.
+func GetFileQuota(role types.Role) types.FileQuota {
+	quota, ok := FileRoleQuotas[role]
+	if !ok {
+		quota = FileRoleQuotas[types.RoleUser]
+	}
+
+	envKey := "FILE_QUOTA_" + strings.ToUpper(string(role)) + "_TOTAL_MB"
+	if megabytes, err := strconv.ParseInt(os.Getenv(envKey), 10, 64); err == nil && megabytes >= 0 {
+		quota.TotalBytes = megabytes << 20
+	}
+	return quota
+}
@@ -0,0 +1,103 @@
+package configs
+
+import (
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-template/types"
+)
+
+// GetGithubPublishApprovalRequired, içerik yayınlamanın onay gerektirip gerektirmediğini döndürür.
+// GITHUB_PUBLISH_REQUIRE_APPROVAL=true ise doğrudan yayınlama kapatılır; değişiklikler yalnızca
+// başka bir kullanıcının onayladığı yayınlama talepleriyle main'e alınabilir.
+func GetGithubPublishApprovalRequired() bool {
+	required, _ := strconv.ParseBool(os.Getenv("GITHUB_PUBLISH_REQUIRE_APPROVAL"))
+	return required
+}
+
+// GetGithubContentSchemaDir, içerik şemalarının sunucudaki klasörünü döndürür (GITHUB_CONTENT_SCHEMA_DIR).
+// Şemalar <klasör>/<kategori>/ altında aranır; içerik reposundaki şemalar bunlara göre önceliklidir.
+func GetGithubContentSchemaDir() string {
+	return os.Getenv("GITHUB_CONTENT_SCHEMA_DIR")
+}
+
+// GetGithubI18nReferenceLocale, çeviri dosyalarının anahtar karşılaştırmasında referans alınan dili döndürür.
+// GITHUB_I18N_REFERENCE_LOCALE tanımlı değilse "en" kullanılır.
+func GetGithubI18nReferenceLocale() string {
+	if locale := os.Getenv("GITHUB_I18N_REFERENCE_LOCALE"); locale != "" {
+		return locale
+	}
+	return "en"
+}
+
+// GetGithubBranchCacheTTL, branch son commit'lerinin önbellekte tutulma süresini döndürür (GITHUB_BRANCH_CACHE_TTL, ör. "30s").
+// Uygulamanın kendi commit'leri önbelleği hemen temizler; süre yalnızca GitHub üzerinde doğrudan yapılan değişikliklerin
+// ne kadar geç görüleceğini belirler. "0" önbelleği kapatır.
+func GetGithubBranchCacheTTL() time.Duration {
+	if ttl, err := time.ParseDuration(os.Getenv("GITHUB_BRANCH_CACHE_TTL")); err == nil && ttl >= 0 {
+		return ttl
+	}
+	return GITHUB_BRANCH_CACHE_TTL
+}
+
+// GetGithubRateLimitReserve, GitHub API kotasında ayrılan yedek istek sayısını döndürür (GITHUB_RATE_LIMIT_RESERVE).
+// Kalan istek sayısı bunun altına indiğinde istekler sıraya alınır ve sıfırlanma zamanına kadar yayılarak gönderilir.
+func GetGithubRateLimitReserve() int {
+	if reserve, err := strconv.Atoi(os.Getenv("GITHUB_RATE_LIMIT_RESERVE")); err == nil && reserve >= 0 {
+		return reserve
+	}
+	return GITHUB_RATE_LIMIT_RESERVE
+}
+
+// GetGithubRateLimitMaxWait, kota dolduğunda bir isteğin en fazla ne kadar bekleyeceğini döndürür (GITHUB_RATE_LIMIT_MAX_WAIT).
+// Sıfırlanma daha uzak ise istek beklemeden GithubService.ErrRateLimited ile başarısız olur.
+func GetGithubRateLimitMaxWait() time.Duration {
+	if wait, err := time.ParseDuration(os.Getenv("GITHUB_RATE_LIMIT_MAX_WAIT")); err == nil && wait >= 0 {
+		return wait
+	}
+	return GITHUB_RATE_LIMIT_MAX_WAIT
+}
+
+var (
+	// themeAllowedAtRules, tema dosyalarında kullanılabilecek CSS at-rule'larıdır.
+	// @import bilinçli olarak listede yoktur; dış stil dosyaları tarayıcıda doğrudan yüklenir.
+	themeAllowedAtRules = []string{
+		"media", "supports", "font-face", "keyframes", "page", "layer", "container", "charset",
+		"namespace", "property", "counter-style", "font-feature-values", "font-palette-values",
+		"scope", "starting-style",
+	}
+	// themeSCSSAtRules, yalnızca .scss dosyalarında izin verilen ek at-rule'lardır. SCSS derleme sırasında
+	// çözüldüğünden @import burada yalnızca partial dosyaları içeri alır.
+	themeSCSSAtRules = []string{
+		"use", "forward", "import", "mixin", "include", "function", "return", "if", "else", "each",
+		"for", "while", "extend", "content", "at-root", "debug", "warn", "error",
+	}
+	themeAllowedURLSchemes = []string{"https", "data"}
+)
+
+// GetThemeCSSPolicy, tema dosyalarının doğrulama kurallarını döndürür.
+// Uzak url() adreslerinde GITHUB_THEME_URL_HOSTS (virgülle ayrılmış) listesindeki sunucular ve
+// FILE_CDN_URL_BASE sunucusu kabul edilir.
+func GetThemeCSSPolicy(scss bool) types.CSSPolicy {
+	policy := types.CSSPolicy{
+		AllowedAtRules:    slices.Clone(themeAllowedAtRules),
+		AllowedURLSchemes: slices.Clone(themeAllowedURLSchemes),
+	}
+	if scss {
+		policy.AllowedAtRules = append(policy.AllowedAtRules, themeSCSSAtRules...)
+	}
+
+	for _, host := range strings.Split(os.Getenv("GITHUB_THEME_URL_HOSTS"), ",") {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			policy.AllowedURLHosts = append(policy.AllowedURLHosts, host)
+		}
+	}
+	if cdn, err := url.Parse(GetFileCDNURLBase()); err == nil && cdn.Hostname() != "" {
+		policy.AllowedURLHosts = append(policy.AllowedURLHosts, strings.ToLower(cdn.Hostname()))
+	}
+	return policy
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS files_multipart_uploads;
+
+ALTER TABLE files ALTER COLUMN size_in_bytes TYPE INTEGER;
+
+DROP TYPE IF EXISTS multipart_upload_status;
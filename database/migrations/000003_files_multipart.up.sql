@@ -0,0 +1,26 @@
+CREATE TYPE multipart_upload_status AS ENUM ('pending', 'completed', 'aborted');
+
+-- Büyük dosyalar (video, büyük PDF vb.) 2GB sınırını aşabileceği için boyut kolonunu genişlet
+ALTER TABLE files ALTER COLUMN size_in_bytes TYPE BIGINT;
+
+-- ÇOK PARÇALI YÜKLEME TABLOSU (S3 multipart upload takibi)
+CREATE TABLE IF NOT EXISTS files_multipart_uploads (
+    id TEXT PRIMARY KEY,
+    upload_id TEXT NOT NULL, -- R2 tarafından verilen multipart upload ID'si
+    object_key TEXT NOT NULL, -- Nesnenin R2'deki tam yolu
+    upload_url TEXT NOT NULL, -- Dosyanın erişileceği URL
+    filename TEXT NOT NULL, -- Dosya adı
+    file_type TEXT NOT NULL, -- MIME tipi
+    file_category TEXT, -- 'video', 'document', vb.
+    size_in_bytes BIGINT NOT NULL, -- Beklenen toplam boyut
+    part_size BIGINT NOT NULL, -- Her parçanın boyutu (son parça hariç)
+    part_count INTEGER NOT NULL, -- Toplam parça sayısı
+    status multipart_upload_status NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMPTZ NOT NULL, -- Bu süreden sonra tamamlanmamış yükleme iptal edilir
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_files_multipart_uploads_status_expires ON files_multipart_uploads (status, expires_at);
+
+CREATE TRIGGER trigger_files_multipart_uploads_updated_at BEFORE UPDATE ON files_multipart_uploads FOR EACH ROW EXECUTE FUNCTION update_timestamp_on_change();
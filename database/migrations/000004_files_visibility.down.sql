@@ -0,0 +1,7 @@
+ALTER TABLE files_multipart_uploads DROP COLUMN IF EXISTS visibility;
+
+ALTER TABLE files_signatures DROP COLUMN IF EXISTS visibility;
+
+ALTER TABLE files DROP COLUMN IF EXISTS visibility;
+
+DROP TYPE IF EXISTS file_visibility;
@@ -0,0 +1,9 @@
+CREATE TYPE file_visibility AS ENUM ('public', 'private');
+
+-- Özgeçmiş, sertifika gibi kişisel belgeler herkese açık CDN üzerinden sunulmaz.
+-- Private dosyalar yalnızca kısa ömürlü imzalı indirme URL'leri ile erişilebilir.
+ALTER TABLE files ADD COLUMN IF NOT EXISTS visibility file_visibility NOT NULL DEFAULT 'public';
+
+ALTER TABLE files_signatures ADD COLUMN IF NOT EXISTS visibility file_visibility NOT NULL DEFAULT 'public';
+
+ALTER TABLE files_multipart_uploads ADD COLUMN IF NOT EXISTS visibility file_visibility NOT NULL DEFAULT 'public';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_files_content_hash;
+
+ALTER TABLE files_signatures DROP COLUMN IF EXISTS content_hash;
+
+ALTER TABLE files DROP COLUMN IF EXISTS content_hash;
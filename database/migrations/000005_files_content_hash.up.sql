@@ -0,0 +1,6 @@
+-- Aynı içeriğin tekrar tekrar yüklenmesini önlemek için dosyaların SHA-256 özeti (hex) saklanır
+ALTER TABLE files ADD COLUMN IF NOT EXISTS content_hash TEXT;
+
+ALTER TABLE files_signatures ADD COLUMN IF NOT EXISTS content_hash TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_files_content_hash ON files (content_hash, file_category) WHERE content_hash IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_files_trash;
+
+ALTER TABLE files DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,7 @@
+-- Silinen dosyalar çöp kutusuna taşınır; saklama süresi dolduğunda kalıcı olarak temizlenir
+ALTER TABLE files ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Daha önce silinmiş kayıtlar için silinme zamanı olarak son güncelleme zamanını kullan
+UPDATE files SET deleted_at = updated_at WHERE status = 'deleted' AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_files_trash ON files (deleted_at) WHERE status = 'deleted';
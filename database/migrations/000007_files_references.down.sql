@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS files_references;
+
+DROP TYPE IF EXISTS file_reference_source;
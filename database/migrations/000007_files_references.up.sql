@@ -0,0 +1,18 @@
+CREATE TYPE file_reference_source AS ENUM ('content', 'user_avatar');
+
+-- DOSYA REFERANS İNDEKSİ: Hangi içerik dosyasının (kategori + yol + branch) veya hangi kullanıcının
+-- (avatar) bir dosya URL'sini kullandığını tutar. Kullanımdaki dosyaların silinmesini engellemek için kullanılır.
+CREATE TABLE IF NOT EXISTS files_references (
+    id TEXT PRIMARY KEY,
+    file_url TEXT NOT NULL, -- files.url ile eşleşen URL
+    source_type file_reference_source NOT NULL,
+    content_category TEXT, -- 'i18n', 'config', 'theme' (source_type = 'content')
+    content_path TEXT, -- Repo içindeki dosya yolu (source_type = 'content')
+    content_branch TEXT, -- Referansın bulunduğu branch (source_type = 'content')
+    user_id TEXT, -- Avatarı bu dosya olan kullanıcı (source_type = 'user_avatar')
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_files_references_file_url ON files_references (file_url);
+
+CREATE INDEX IF NOT EXISTS idx_files_references_content ON files_references (content_category, content_branch, content_path);
@@ -0,0 +1,4 @@
+-- Medya kütüphanesinde dosyaları gruplamak ve filtrelemek için serbest etiketler
+ALTER TABLE files ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_files_tags ON files USING GIN (tags);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_files_references_object_key;
+
+ALTER TABLE files_references DROP COLUMN IF EXISTS object_key;
+
+ALTER TABLE files_signatures DROP COLUMN IF EXISTS object_key;
+
+DROP INDEX IF EXISTS idx_files_object_key;
+
+ALTER TABLE files DROP COLUMN IF EXISTS object_key;
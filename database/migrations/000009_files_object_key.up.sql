@@ -0,0 +1,23 @@
+-- Nesnenin storage üzerindeki anahtarı artık URL'den türetilmez, açıkça saklanır.
+-- Public URL'ler okuma anında yapılandırılabilir CDN adresinden üretilir.
+ALTER TABLE files ADD COLUMN IF NOT EXISTS object_key TEXT;
+
+-- Mevcut kayıtlar için anahtarı URL'nin yol kısmından doldur (https://host/uploads/a.png -> uploads/a.png)
+UPDATE files SET object_key = regexp_replace(url, '^https?://[^/]+/', '') WHERE object_key IS NULL;
+
+ALTER TABLE files ALTER COLUMN object_key SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_files_object_key ON files (object_key);
+
+ALTER TABLE files_signatures ADD COLUMN IF NOT EXISTS object_key TEXT;
+
+UPDATE files_signatures SET object_key = regexp_replace(upload_url, '^https?://[^/]+/', '') WHERE object_key IS NULL;
+
+-- Referanslar, içeriklerde hangi domain kullanılmış olursa olsun dosyalarla anahtar üzerinden eşleşir
+ALTER TABLE files_references ADD COLUMN IF NOT EXISTS object_key TEXT;
+
+UPDATE files_references SET object_key = regexp_replace(file_url, '^https?://[^/]+/', '') WHERE object_key IS NULL;
+
+ALTER TABLE files_references ALTER COLUMN object_key SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_files_references_object_key ON files_references (object_key);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS files_scan_events;
+
+DROP TYPE IF EXISTS file_scan_result;
+
+-- PostgreSQL enum değerlerinin silinmesini desteklemez; karantinadaki dosyalar beklemeye alınır
+UPDATE files SET status = 'pending' WHERE status = 'quarantined';
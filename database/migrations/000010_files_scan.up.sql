@@ -0,0 +1,23 @@
+-- Tarama süren dosyalar 'quarantined' durumunda tutulur ve listelenmez
+ALTER TYPE file_status ADD VALUE IF NOT EXISTS 'quarantined';
+
+CREATE TYPE file_scan_result AS ENUM ('clean', 'infected', 'error');
+
+-- TARAMA KAYITLARI: Her taramanın sonucu saklanır. Zararlı bulunan dosyaların kaydı silindiğinden
+-- dosya bilgileri burada kopyalanır ve file_id bir yabancı anahtar değildir.
+CREATE TABLE IF NOT EXISTS files_scan_events (
+    id TEXT PRIMARY KEY,
+    file_id TEXT NOT NULL,
+    object_key TEXT NOT NULL,
+    filename TEXT NOT NULL,
+    file_category TEXT NOT NULL,
+    scanner TEXT NOT NULL, -- 'clamav', 'fake'
+    result file_scan_result NOT NULL,
+    signature TEXT, -- Tespit edilen zararlı yazılımın adı (result = 'infected')
+    message TEXT, -- Hata açıklaması (result = 'error')
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_files_scan_events_file_id ON files_scan_events (file_id);
+
+CREATE INDEX IF NOT EXISTS idx_files_scan_events_result ON files_scan_events (result, created_at);
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_files_multipart_uploads_uploaded_by;
+
+ALTER TABLE files_multipart_uploads DROP COLUMN IF EXISTS uploaded_by;
+
+DROP INDEX IF EXISTS idx_files_signatures_reservations;
+
+ALTER TABLE files_signatures DROP COLUMN IF EXISTS size_in_bytes;
+
+ALTER TABLE files_signatures DROP COLUMN IF EXISTS uploaded_by;
+
+DROP INDEX IF EXISTS idx_files_uploaded_by;
+
+ALTER TABLE files DROP COLUMN IF EXISTS uploaded_by;
@@ -0,0 +1,15 @@
+-- Depolama kotası hesabı için dosyayı yükleyen kullanıcı saklanır. Eski kayıtlarda bu bilgi yoktur (NULL).
+ALTER TABLE files ADD COLUMN IF NOT EXISTS uploaded_by TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_files_uploaded_by ON files (uploaded_by, file_category);
+
+-- İmzalar, yükleme tamamlanana veya süresi dolana kadar kullanıcının kotasından yer ayırır
+ALTER TABLE files_signatures ADD COLUMN IF NOT EXISTS uploaded_by TEXT;
+
+ALTER TABLE files_signatures ADD COLUMN IF NOT EXISTS size_in_bytes BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_files_signatures_reservations ON files_signatures (uploaded_by, expires_at) WHERE completed = FALSE;
+
+ALTER TABLE files_multipart_uploads ADD COLUMN IF NOT EXISTS uploaded_by TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_files_multipart_uploads_uploaded_by ON files_multipart_uploads (uploaded_by) WHERE status = 'pending';
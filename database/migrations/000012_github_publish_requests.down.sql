@@ -0,0 +1,7 @@
+DELETE FROM permissions WHERE name = 'github:approve-publish';
+
+DROP TABLE IF EXISTS github_publish_request_comments;
+
+DROP TABLE IF EXISTS github_publish_requests;
+
+DROP TYPE IF EXISTS github_publish_request_status;
@@ -0,0 +1,49 @@
+-- İçerik yayınlama onay akışı: 'approved' birleştirme sürerken geçici durumdur,
+-- birleştirme sonucuna göre 'published' veya 'failed' olur.
+CREATE TYPE github_publish_request_status AS ENUM ('pending', 'approved', 'published', 'failed', 'rejected');
+
+-- YAYINLAMA TALEPLERİ: Her talep GitHub'da açılmış bir Pull Request'e karşılık gelir
+CREATE TABLE IF NOT EXISTS github_publish_requests (
+    id TEXT PRIMARY KEY,
+    category TEXT NOT NULL,
+    branch TEXT NOT NULL,
+    head_sha TEXT NOT NULL, -- Talep anındaki taslak commit'i; sonradan yapılan değişiklikler onaysız yayınlanmaz
+    pr_number INTEGER NOT NULL,
+    pr_url TEXT NOT NULL,
+    message TEXT,
+    status github_publish_request_status DEFAULT 'pending' NOT NULL,
+    requested_by TEXT NOT NULL,
+    reviewed_by TEXT,
+    review_note TEXT,
+    reviewed_at TIMESTAMPTZ,
+    result_message TEXT, -- Birleştirme raporu veya hata açıklaması
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    FOREIGN KEY (requested_by) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewed_by) REFERENCES users (id) ON DELETE SET NULL
+);
+
+-- Bir kategori için aynı anda yalnızca bir açık talep olabilir
+CREATE UNIQUE INDEX IF NOT EXISTS idx_github_publish_requests_open ON github_publish_requests (category) WHERE status IN ('pending', 'approved');
+
+CREATE INDEX IF NOT EXISTS idx_github_publish_requests_status ON github_publish_requests (status, created_at);
+
+-- TALEP YORUMLARI
+CREATE TABLE IF NOT EXISTS github_publish_request_comments (
+    id TEXT PRIMARY KEY,
+    request_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    FOREIGN KEY (request_id) REFERENCES github_publish_requests (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_github_publish_request_comments_request_id ON github_publish_request_comments (request_id, created_at);
+
+CREATE TRIGGER trigger_github_publish_requests_updated_at BEFORE UPDATE ON github_publish_requests FOR EACH ROW EXECUTE FUNCTION update_timestamp_on_change();
+
+-- Onay yetkisi
+INSERT INTO permissions (id, name, description)
+VALUES (gen_random_uuid ()::TEXT, 'github:approve-publish', 'İçerik yayınlama taleplerini onaylama veya reddetme')
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS github_publish_schedules;
+
+DROP TYPE IF EXISTS github_publish_schedule_status;
@@ -0,0 +1,30 @@
+-- Zamanlanmış içerik yayınlama: 'running' yayınlama sürerken geçici durumdur
+CREATE TYPE github_publish_schedule_status AS ENUM ('scheduled', 'running', 'published', 'skipped', 'failed', 'cancelled');
+
+-- ZAMANLANMIŞ YAYINLAR: Otomasyon kayıtları bellekte tutulduğundan, bekleyen zamanlamalar
+-- sunucu açılışında bu tablodan yeniden yüklenir.
+CREATE TABLE IF NOT EXISTS github_publish_schedules (
+    id TEXT PRIMARY KEY,
+    category TEXT NOT NULL,
+    branch TEXT NOT NULL,
+    head_sha TEXT NOT NULL, -- Zamanlama anındaki taslak commit'i
+    publish_at TIMESTAMPTZ NOT NULL,
+    allow_draft_changes BOOLEAN DEFAULT FALSE NOT NULL, -- Zamanlamadan sonra taslak değişse de yayınla
+    message TEXT,
+    status github_publish_schedule_status DEFAULT 'scheduled' NOT NULL,
+    result_message TEXT, -- Yayınlama raporu, atlanma nedeni veya hata açıklaması
+    created_by TEXT NOT NULL,
+    cancelled_by TEXT,
+    executed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (cancelled_by) REFERENCES users (id) ON DELETE SET NULL
+);
+
+-- Bir kategori için aynı anda yalnızca bir bekleyen zamanlama olabilir
+CREATE UNIQUE INDEX IF NOT EXISTS idx_github_publish_schedules_open ON github_publish_schedules (category) WHERE status IN ('scheduled', 'running');
+
+CREATE INDEX IF NOT EXISTS idx_github_publish_schedules_status ON github_publish_schedules (status, publish_at);
+
+CREATE TRIGGER trigger_github_publish_schedules_updated_at BEFORE UPDATE ON github_publish_schedules FOR EACH ROW EXECUTE FUNCTION update_timestamp_on_change();
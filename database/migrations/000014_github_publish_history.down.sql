@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS github_publish_history;
+
+DROP TYPE IF EXISTS github_publish_source;
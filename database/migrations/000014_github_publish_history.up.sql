@@ -0,0 +1,23 @@
+CREATE TYPE github_publish_source AS ENUM ('direct', 'approval', 'schedule', 'rollback');
+
+-- YAYIN GEÇMİŞİ: main'e alınan her yayın ve geri alma işlemi kaydedilir.
+-- base_sha, yayından önceki main commit'idir; geri alma dosyaları bu commit'teki hallerine döndürür.
+CREATE TABLE IF NOT EXISTS github_publish_history (
+    id TEXT PRIMARY KEY,
+    category TEXT NOT NULL,
+    source github_publish_source NOT NULL,
+    pr_number INTEGER, -- Geri alma işlemleri doğrudan commit olduğundan PR numarası yoktur
+    merge_sha TEXT NOT NULL,
+    base_sha TEXT NOT NULL,
+    files JSONB DEFAULT '[]'::JSONB NOT NULL, -- [{ "path", "previousPath", "status" }]
+    message TEXT,
+    report TEXT,
+    published_by TEXT,
+    rollback_of TEXT, -- Geri alma kaydının geri aldığı yayın
+    rolled_back_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    FOREIGN KEY (published_by) REFERENCES users (id) ON DELETE SET NULL,
+    FOREIGN KEY (rollback_of) REFERENCES github_publish_history (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_github_publish_history_category ON github_publish_history (category, created_at);
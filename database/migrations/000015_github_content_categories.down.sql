@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS github_content_categories;
+
+DROP TYPE IF EXISTS github_content_validator;
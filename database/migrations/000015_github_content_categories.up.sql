@@ -0,0 +1,35 @@
+-- İçerik doğrulayıcıları: 'i18n' JSON doğrulamasına ek olarak dil dosyalarının anahtar uyumunu kontrol eder
+CREATE TYPE github_content_validator AS ENUM ('none', 'json', 'i18n', 'css');
+
+-- İÇERİK KATEGORİLERİ: Her kategori kendi reposunu, temel branch'ini ve kurallarını tanımlar.
+-- repo_owner/repo_name boşsa GITHUB_OWNER ve GITHUB_REPOSITORY_NAME kullanılır.
+CREATE TABLE IF NOT EXISTS github_content_categories (
+    type TEXT PRIMARY KEY, -- URL'deki kategori adı (ör. /v1/github/i18n)
+    name TEXT NOT NULL,
+    description TEXT DEFAULT '' NOT NULL,
+    repo_owner TEXT,
+    repo_name TEXT,
+    base_branch TEXT DEFAULT 'main' NOT NULL,
+    path TEXT NOT NULL,
+    extensions TEXT[] DEFAULT '{}' NOT NULL,
+    max_size BIGINT DEFAULT 0 NOT NULL, -- byte, 0 sınırsız
+    validator github_content_validator DEFAULT 'none' NOT NULL,
+    draft_branch TEXT NOT NULL,
+    schema_path TEXT, -- İçerik reposunda JSON şemalarının bulunduğu klasör
+    enabled BOOLEAN DEFAULT TRUE NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW () NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW () NOT NULL
+);
+
+-- Aynı repoda iki kategori aynı taslak branch'i kullanamaz
+CREATE UNIQUE INDEX IF NOT EXISTS idx_github_content_categories_draft_branch ON github_content_categories (COALESCE(repo_owner, ''), COALESCE(repo_name, ''), draft_branch);
+
+CREATE TRIGGER trigger_github_content_categories_updated_at BEFORE UPDATE ON github_content_categories FOR EACH ROW EXECUTE FUNCTION update_timestamp_on_change();
+
+-- Önceden kod içinde tanımlı olan kategoriler
+INSERT INTO github_content_categories (type, name, description, path, extensions, max_size, validator, draft_branch, schema_path)
+VALUES
+    ('i18n', 'Internationalization', 'Translation files for different languages', 'src/messages', '{.json}', 1048576, 'i18n', 'i18n-draft', 'schemas/i18n'),
+    ('config', 'Configuration', 'Application configuration files', 'src/config', '{.json,.js,.ts}', 524288, 'json', 'config-draft', 'schemas/config'),
+    ('theme', 'Theme & Styling', 'CSS and SCSS styling files', 'src/styles', '{.css,.scss}', 2097152, 'css', 'theme-draft', NULL)
+ON CONFLICT (type) DO NOTHING;
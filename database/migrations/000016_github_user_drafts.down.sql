@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_github_publish_schedules_open;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_github_publish_schedules_open ON github_publish_schedules (category) WHERE status IN ('scheduled', 'running');
+
+DROP INDEX IF EXISTS idx_github_publish_requests_open;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_github_publish_requests_open ON github_publish_requests (category) WHERE status IN ('pending', 'approved');
+
+ALTER TABLE github_content_categories DROP COLUMN IF EXISTS user_drafts;
@@ -0,0 +1,11 @@
+-- KİŞİSEL TASLAKLAR: Etkin kategorilerde her kullanıcı kendi taslak branch'inde çalışır (ör. "i18n-draft/<userId>").
+-- Git "i18n-draft" ve "i18n-draft/<userId>" branch'lerinin birlikte var olmasına izin vermez; bu yüzden kişisel
+-- taslak modundaki kategorilerde paylaşılan taslak branch'i oluşturulmaz.
+ALTER TABLE github_content_categories ADD COLUMN IF NOT EXISTS user_drafts BOOLEAN DEFAULT FALSE NOT NULL;
+
+-- Açık talep ve zamanlama kısıtları kategori yerine taslak branch'i başına uygulanır
+DROP INDEX IF EXISTS idx_github_publish_requests_open;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_github_publish_requests_open ON github_publish_requests (category, branch) WHERE status IN ('pending', 'approved');
+
+DROP INDEX IF EXISTS idx_github_publish_schedules_open;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_github_publish_schedules_open ON github_publish_schedules (category, branch) WHERE status IN ('scheduled', 'running');
@@ -0,0 +1,2 @@
+-- PostgreSQL enum değerlerinin silinmesini desteklemez; taranamayan dosyalar yeniden karantinaya alınır
+UPDATE files SET status = 'quarantined' WHERE status = 'scan_failed';
@@ -0,0 +1,3 @@
+-- Taranamayan dosyalar (tarayıcının boyut sınırını aşanlar veya art arda hata verenler) yeniden denenmez,
+-- listelenmez ve bir admin tarafından incelenene kadar 'scan_failed' durumunda kalır
+ALTER TYPE file_status ADD VALUE IF NOT EXISTS 'scan_failed';
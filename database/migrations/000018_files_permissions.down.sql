@@ -0,0 +1,2 @@
+DELETE FROM permissions
+WHERE name IN ('file:upload', 'file:restore', 'file:view-trash', 'file:move', 'file:tag', 'file:download', 'file:manage-private');
@@ -0,0 +1,11 @@
+-- Dosya yönetimi için eklenen yetkiler
+INSERT INTO permissions (id, name, description)
+VALUES
+    (gen_random_uuid ()::TEXT, 'file:upload', 'Dosyaları sunucu üzerinden yükleme'),
+    (gen_random_uuid ()::TEXT, 'file:restore', 'Çöp kutusundaki dosyaları geri yükleme'),
+    (gen_random_uuid ()::TEXT, 'file:view-trash', 'Çöp kutusundaki dosyaları listeleme'),
+    (gen_random_uuid ()::TEXT, 'file:move', 'Dosyaları başka bir kategoriye taşıma'),
+    (gen_random_uuid ()::TEXT, 'file:tag', 'Dosyalara etiket ekleme veya çıkarma'),
+    (gen_random_uuid ()::TEXT, 'file:download', 'Dosyaları süreli imzalı bağlantı ile indirme'),
+    (gen_random_uuid ()::TEXT, 'file:manage-private', 'Private dosya kategorilerine (cv, ön yazı, sertifika) erişim')
+ON CONFLICT (name) DO NOTHING;
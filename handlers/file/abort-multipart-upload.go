@@ -0,0 +1,76 @@
+// handlers/file/abort-multipart-upload.go
+package FileHandler
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// AbortMultipartUpload devam eden bir çok parçalı yüklemeyi iptal eder
+func (h *Handler) AbortMultipartUpload(c *gin.Context) {
+	upload := h.getPendingMultipartUpload(c)
+	if upload == nil {
+		return
+	}
+
+	err := h.StorageService.AbortMultipartUpload(c.Request.Context(), upload.ObjectKey, upload.UploadID)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"success": false,
+			"error":   "multipart_abort_failed",
+			"message": "Yükleme iptal edilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	err = h.FileRepository.UpdateMultipartUploadStatus(c.Request.Context(), upload.ID, types.MultipartUploadStatusAborted)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "upload_update_failed",
+			"message": "Yükleme kaydı güncellenemedi: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Yükleme iptal edildi",
+	})
+}
+
+// CleanupExpiredMultipartUploads süresi dolmuş çok parçalı yüklemeleri iptal eder.
+// AutomationService tarafından periyodik olarak çağrılır.
+func (h *Handler) CleanupExpiredMultipartUploads(ctx context.Context) {
+	// 1. Veritabanında süresi dolmuş olarak görünen yüklemeleri iptal et
+	uploads, err := h.FileRepository.GetExpiredMultipartUploads(ctx)
+	if err != nil {
+		log.Printf("[FILES] Süresi dolmuş multipart yüklemeler alınamadı: %v", err)
+		return
+	}
+
+	for _, upload := range uploads {
+		if err := h.StorageService.AbortMultipartUpload(ctx, upload.ObjectKey, upload.UploadID); err != nil {
+			log.Printf("[FILES] Multipart yükleme iptal edilemedi (id: %s): %v", upload.ID, err)
+		}
+		if err := h.FileRepository.UpdateMultipartUploadStatus(ctx, upload.ID, types.MultipartUploadStatusAborted); err != nil {
+			log.Printf("[FILES] Multipart yükleme durumu güncellenemedi (id: %s): %v", upload.ID, err)
+		}
+	}
+
+	// 2. Veritabanında kaydı olmayan (yetim) yüklemeleri R2 tarafında temizle
+	orphans, err := h.StorageService.AbortStaleMultipartUploads(ctx, time.Now().Add(-configs.FILE_MULTIPART_UPLOAD_EXPIRY))
+	if err != nil {
+		log.Printf("[FILES] Yetim multipart yüklemeler temizlenemedi: %v", err)
+	}
+
+	if len(uploads) > 0 || orphans > 0 {
+		log.Printf("[FILES] %d süresi dolmuş, %d yetim multipart yükleme iptal edildi.", len(uploads), orphans)
+	}
+}
@@ -0,0 +1,238 @@
+// handlers/file/batch.go
+package FileHandler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// BatchDeleteFiles birden fazla dosyayı çöp kutusuna taşır.
+// Kullanımdaki dosyalar force=true gönderilmedikçe atlanır ve sonuçta hata olarak raporlanır.
+func (h *Handler) BatchDeleteFiles(c *gin.Context) {
+	var input types.FileBatchDeleteInput
+	if h.ValidationService.Validate(c, &input) != nil {
+		return
+	}
+
+	response := runBatch(c.Request.Context(), input.IDs, func(ctx context.Context, fileID uuid.UUID) types.FileBatchItemResult {
+		file, result := h.getBatchFile(c, ctx, fileID)
+		if file == nil {
+			return result
+		}
+
+		if !input.Force {
+			if result, inUse := h.checkBatchFileReferences(ctx, file); inUse {
+				return result
+			}
+		}
+
+		if err := h.FileRepository.DeleteFile(ctx, fileID); err != nil {
+			return batchFailure(fileID, "file_delete_failed", "Dosya silinemedi: "+err.Error())
+		}
+
+		return types.FileBatchItemResult{ID: fileID.String(), Success: true, Message: "Dosya çöp kutusuna taşındı"}
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": response.Failed == 0,
+		"data":    response,
+	})
+}
+
+// BatchMoveFiles dosyaları başka bir kategoriye taşır.
+// Her dosya için storage nesnesi yeni kategori klasörüne kopyalanır, kayıt güncellenir ve eski nesne silinir.
+func (h *Handler) BatchMoveFiles(c *gin.Context) {
+	var input types.FileBatchMoveInput
+	if h.ValidationService.Validate(c, &input) != nil {
+		return
+	}
+
+	policy := h.getFileCategoryPolicy(c, input.Category)
+	if policy == nil {
+		return
+	}
+
+	response := runBatch(c.Request.Context(), input.IDs, func(ctx context.Context, fileID uuid.UUID) types.FileBatchItemResult {
+		file, result := h.getBatchFile(c, ctx, fileID)
+		if file == nil {
+			return result
+		}
+
+		if file.FileCategory == policy.Name {
+			return types.FileBatchItemResult{ID: fileID.String(), Success: true, Message: "Dosya zaten bu kategoride", URL: h.fileAccessURL(fileID, file.ObjectKey, file.Visibility)}
+		}
+
+		if !configs.IsFileTypeAllowed(*policy, file.Filename, file.FileType) || file.SizeInBytes > policy.MaxSize {
+			return batchFailure(fileID, "file_type_not_allowed", fmt.Sprintf("Dosya '%s' kategorisinin kurallarına uymuyor", policy.Name))
+		}
+
+		if !input.Force {
+			if result, inUse := h.checkBatchFileReferences(ctx, file); inUse {
+				return result
+			}
+		}
+
+		// 1. Nesneyi yeni kategori klasörüne kopyala
+		oldKey := file.ObjectKey
+		newKey := utils.RelocateObjectKey(oldKey, policy.Name, policy.Visibility)
+		if err := h.StorageService.CopyObject(ctx, oldKey, newKey); err != nil {
+			return batchFailure(fileID, "object_copy_failed", "Dosya kopyalanamadı: "+err.Error())
+		}
+
+		// 2. Kaydı güncelle; başarısız olursa kopyayı geri al
+		if err := h.FileRepository.UpdateFileLocation(ctx, fileID, newKey, h.publicFileURL(newKey), policy.Name, policy.Visibility); err != nil {
+			h.StorageService.DeleteObject(ctx, newKey)
+			return batchFailure(fileID, "file_update_failed", "Dosya kaydı güncellenemedi: "+err.Error())
+		}
+
+		// 3. Eski nesneyi sil. Silinemezse dosya yine de taşınmış sayılır, eski nesne yetim kalır.
+		message := "Dosya taşındı"
+		if err := h.StorageService.DeleteObject(ctx, oldKey); err != nil {
+			log.Printf("[FILES] Taşınan dosyanın eski nesnesi silinemedi (key: %s): %v", oldKey, err)
+			message = "Dosya taşındı, ancak eski nesne silinemedi"
+		}
+
+		return types.FileBatchItemResult{
+			ID:      fileID.String(),
+			Success: true,
+			Message: message,
+			URL:     h.fileAccessURL(fileID, newKey, policy.Visibility),
+		}
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": response.Failed == 0,
+		"data":    response,
+	})
+}
+
+// BatchTagFiles birden fazla dosyaya etiket ekler ve/veya çıkarır
+func (h *Handler) BatchTagFiles(c *gin.Context) {
+	var input types.FileBatchTagInput
+	if h.ValidationService.Validate(c, &input) != nil {
+		return
+	}
+
+	if len(input.Add) == 0 && len(input.Remove) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "missing_tags",
+			"message": "Eklenecek veya çıkarılacak en az bir etiket gereklidir",
+		})
+		return
+	}
+
+	response := runBatch(c.Request.Context(), input.IDs, func(ctx context.Context, fileID uuid.UUID) types.FileBatchItemResult {
+		file, result := h.getBatchFile(c, ctx, fileID)
+		if file == nil {
+			return result
+		}
+
+		tags, err := h.FileRepository.UpdateFileTags(ctx, fileID, input.Add, input.Remove)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return batchFailure(fileID, "file_not_found", "Dosya bulunamadı")
+			}
+			return batchFailure(fileID, "file_update_failed", "Etiketler güncellenemedi: "+err.Error())
+		}
+
+		return types.FileBatchItemResult{ID: fileID.String(), Success: true, Tags: tags}
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": response.Failed == 0,
+		"data":    response,
+	})
+}
+
+// runBatch her ID için işlemi en fazla FILE_BATCH_WORKERS eşzamanlı işçiyle çalıştırır.
+// Sonuçlar istekteki sırayla döner; tekrar eden ID'ler yalnızca bir kez işlenir.
+func runBatch(ctx context.Context, ids []string, process func(ctx context.Context, fileID uuid.UUID) types.FileBatchItemResult) types.FileBatchResponse {
+	seen := make(map[string]bool, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	results := make([]types.FileBatchItemResult, len(uniqueIDs))
+	semaphore := make(chan struct{}, configs.FILE_BATCH_WORKERS)
+
+	var wg sync.WaitGroup
+	for i, id := range uniqueIDs {
+		fileID, err := uuid.Parse(id)
+		if err != nil {
+			results[i] = types.FileBatchItemResult{ID: id, Error: "invalid_file_id", Message: "Geçersiz dosya ID'si"}
+			continue
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, fileID uuid.UUID) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			results[i] = process(ctx, fileID)
+		}(i, fileID)
+	}
+	wg.Wait()
+
+	response := types.FileBatchResponse{Total: len(results), Results: results}
+	for _, result := range results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response
+}
+
+// getBatchFile toplu işlemdeki bir dosyayı getirir ve kategori yetkisini kontrol eder.
+// Dosya işlenemiyorsa nil ve hata sonucu döner.
+func (h *Handler) getBatchFile(c *gin.Context, ctx context.Context, fileID uuid.UUID) (*types.File, types.FileBatchItemResult) {
+	file, err := h.FileRepository.GetFileByID(ctx, fileID)
+	if err != nil {
+		return nil, batchFailure(fileID, "file_fetch_failed", "Dosya bilgileri getirilemedi: "+err.Error())
+	}
+
+	if file == nil {
+		return nil, batchFailure(fileID, "file_not_found", "Dosya bulunamadı")
+	}
+
+	if !canAccessCategory(c, file.FileCategory) {
+		return nil, batchFailure(fileID, "insufficient_permissions", "Bu kategorideki dosyalar için yetkiniz bulunmamaktadır.")
+	}
+
+	return file, types.FileBatchItemResult{}
+}
+
+// checkBatchFileReferences dosya kullanımdaysa hata sonucu ve true döner
+func (h *Handler) checkBatchFileReferences(ctx context.Context, file *types.File) (types.FileBatchItemResult, bool) {
+	references, err := h.FileRepository.GetFileReferences(ctx, file.ObjectKey)
+	if err != nil {
+		return batchFailure(file.ID, "references_fetch_failed", "Dosya referansları kontrol edilemedi: "+err.Error()), true
+	}
+
+	if len(references) > 0 {
+		return batchFailure(file.ID, "file_in_use", fmt.Sprintf("Dosya %d yerde kullanılıyor", len(references))), true
+	}
+
+	return types.FileBatchItemResult{}, false
+}
+
+func batchFailure(fileID uuid.UUID, code, message string) types.FileBatchItemResult {
+	return types.FileBatchItemResult{ID: fileID.String(), Error: code, Message: message}
+}
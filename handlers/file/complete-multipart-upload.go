@@ -0,0 +1,151 @@
+// handlers/file/complete-multipart-upload.go
+package FileHandler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// CompleteMultipartUpload parçaları birleştirir ve dosyayı veritabanına kaydeder
+func (h *Handler) CompleteMultipartUpload(c *gin.Context) {
+	var input types.CompleteMultipartUploadInput
+	if h.ValidationService.Validate(c, &input) != nil {
+		return
+	}
+
+	upload := h.getPendingMultipartUpload(c)
+	if upload == nil {
+		return
+	}
+
+	// Tüm parçaların eksiksiz ve tekrarsız gönderildiğini kontrol et
+	if int32(len(input.Parts)) != upload.PartCount {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "missing_parts",
+			"message": fmt.Sprintf("%d parça bekleniyordu, %d parça gönderildi", upload.PartCount, len(input.Parts)),
+		})
+		return
+	}
+
+	// S3, parçaların artan sırada gönderilmesini bekler
+	sort.Slice(input.Parts, func(i, j int) bool {
+		return input.Parts[i].PartNumber < input.Parts[j].PartNumber
+	})
+	for i, part := range input.Parts {
+		if part.PartNumber != int32(i+1) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "invalid_part_number",
+				"message": fmt.Sprintf("Parça listesi geçersiz: %d numaralı parça eksik veya tekrarlı", i+1),
+			})
+			return
+		}
+	}
+
+	err := h.StorageService.CompleteMultipartUpload(c.Request.Context(), upload.ObjectKey, upload.UploadID, input.Parts)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"success": false,
+			"error":   "multipart_complete_failed",
+			"message": "Çok parçalı yükleme tamamlanamadı: " + err.Error(),
+		})
+		return
+	}
+
+	// Parça URL'leri parça boyutunu bağlamadığından birleşen nesnenin boyutu storage'dan doğrulanır
+	if !h.verifyMultipartSize(c, upload) {
+		return
+	}
+
+	// Dosyayı veritabanına kaydet
+	status := h.initialFileStatus()
+	fileID, err := h.FileRepository.CreateFileRecord(c.Request.Context(), types.SaveFileInput{
+		URL:          h.publicFileURL(upload.ObjectKey),
+		ObjectKey:    upload.ObjectKey,
+		Filename:     upload.Filename,
+		FileType:     upload.FileType,
+		FileCategory: upload.FileCategory,
+		SizeInBytes:  upload.SizeInBytes,
+		Visibility:   upload.Visibility,
+		Status:       status,
+		UploadedBy:   upload.UploadedBy,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_save_failed",
+			"message": "Dosya kaydedilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	// Yükleme kaydını tamamlandı olarak işaretle
+	err = h.FileRepository.UpdateMultipartUploadStatus(c.Request.Context(), upload.ID, types.MultipartUploadStatusCompleted)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "upload_update_failed",
+			"message": "Yükleme kaydı güncellenemedi: " + err.Error(),
+		})
+		return
+	}
+
+	h.scheduleScan(types.File{
+		ID:           fileID,
+		ObjectKey:    upload.ObjectKey,
+		Filename:     upload.Filename,
+		FileCategory: upload.FileCategory,
+		SizeInBytes:  upload.SizeInBytes,
+		Visibility:   upload.Visibility,
+		Status:       string(status),
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"id":         fileID.String(),
+			"url":        h.uploadedFileURL(fileID, upload.ObjectKey, upload.Visibility, status),
+			"visibility": upload.Visibility,
+			"status":     status,
+		},
+	})
+}
+
+// verifyMultipartSize birleşen nesnenin boyutunu başlatılırken bildirilen boyut ve kategori sınırıyla karşılaştırır.
+// Boyut uymazsa nesne silinir, yükleme iptal edildi olarak işaretlenir, uygun yanıt döner ve false döndürür.
+func (h *Handler) verifyMultipartSize(c *gin.Context, upload *types.MultipartUpload) bool {
+	info := h.headUploadedObject(c, upload.ObjectKey)
+	if info == nil {
+		return false
+	}
+
+	maxSize := upload.SizeInBytes
+	if policy, ok := configs.GetFileCategoryPolicy(upload.FileCategory); ok {
+		maxSize = min(maxSize, h.maxUploadSize(policy))
+	}
+	if info.SizeInBytes == upload.SizeInBytes && info.SizeInBytes <= maxSize {
+		return true
+	}
+
+	ctx := c.Request.Context()
+	if err := h.StorageService.DeleteObject(ctx, upload.ObjectKey); err != nil {
+		log.Printf("[FILES] Boyutu uymayan çok parçalı yükleme silinemedi (key: %s): %v", upload.ObjectKey, err)
+	}
+	if err := h.FileRepository.UpdateMultipartUploadStatus(ctx, upload.ID, types.MultipartUploadStatusAborted); err != nil {
+		log.Printf("[FILES] Çok parçalı yükleme kaydı güncellenemedi (id: %s): %v", upload.ID, err)
+	}
+
+	c.JSON(http.StatusBadRequest, gin.H{
+		"success": false,
+		"error":   "file_size_mismatch",
+		"message": fmt.Sprintf("Birleşen dosyanın boyutu (%d byte) başlatılırken bildirilen boyutla (%d byte) eşleşmiyor veya kategori sınırını aşıyor", info.SizeInBytes, upload.SizeInBytes),
+	})
+	return false
+}
@@ -2,7 +2,9 @@
 package FileHandler
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -45,6 +47,35 @@ func (h *Handler) ConfirmUpload(c *gin.Context) {
 		return
 	}
 
+	// İmza yalnızca onu oluşturan kullanıcı tarafından tamamlanabilir
+	if userID, _ := currentUser(c); signature.UploadedBy == nil || *signature.UploadedBy != userID {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"error":   "signature_not_owned",
+			"message": "Bu yükleme kaydı başka bir kullanıcıya ait",
+		})
+		return
+	}
+
+	// Boyut istemcinin bildirdiği değerden değil storage'daki nesneden okunur
+	objectKey := h.signatureObjectKey(signature)
+	info := h.headUploadedObject(c, objectKey)
+	if info == nil {
+		return
+	}
+
+	// Nesne imzada belirtilen boyuttan farklıysa kota ve boyut sınırı atlatılmış olabilir
+	if signature.SizeInBytes > 0 && info.SizeInBytes != signature.SizeInBytes {
+		h.StorageService.DeleteObject(c.Request.Context(), objectKey)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "file_size_mismatch",
+			"message": fmt.Sprintf("Yüklenen dosyanın boyutu (%d byte) imzadaki boyutla (%d byte) eşleşmiyor", info.SizeInBytes, signature.SizeInBytes),
+		})
+		return
+	}
+	sizeInBytes := info.SizeInBytes
+
 	// Dosya kategorisini al
 	fileCategory := signature.FileCategory
 	if input.FileCategory != "" {
@@ -52,13 +83,43 @@ func (h *Handler) ConfirmUpload(c *gin.Context) {
 		fileCategory = input.FileCategory
 	}
 
-	// Dosyayı veritabanına kaydet
+	policy := h.getFileCategoryPolicy(c, fileCategory)
+	if policy == nil || !h.checkFileAllowed(c, policy, signature.Filename, signature.FileType, sizeInBytes) {
+		return
+	}
+
+	// Kategori değiştirilerek private bir dosyanın public listelenmesi (veya tersi) engellenir
+	if policy.Visibility != signature.Visibility {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "file_category_mismatch",
+			"message": "Dosya kategorisi yükleme sırasında belirlenen erişim türüyle uyuşmuyor",
+		})
+		return
+	}
+
+	// Süresi dolan imzanın kotadaki rezervasyonu serbest kaldığından kota yeniden kontrol edilir
+	if time.Now().After(signature.ExpiresAt) && !h.checkStorageQuota(c, policy.Name, sizeInBytes) {
+		return
+	}
+
+	// İstemci içerik özeti bildirdiyse, storage'daki nesnenin gerçekten bu içerikte olduğunu doğrula
+	if signature.ContentHash != nil && !h.verifyContentHash(c, objectKey, info, *signature.ContentHash) {
+		return
+	}
+
+	// Dosyayı veritabanına kaydet. Nesne anahtarı istemcinin gönderdiği URL'den değil imza kaydından alınır.
 	fileInput := types.SaveFileInput{
-		URL:          input.URL,
+		URL:          h.publicFileURL(objectKey),
+		ObjectKey:    objectKey,
 		Filename:     signature.Filename,
 		FileType:     signature.FileType,
-		FileCategory: fileCategory,
-		SizeInBytes:  input.SizeInBytes,
+		FileCategory: policy.Name,
+		SizeInBytes:  sizeInBytes,
+		Visibility:   signature.Visibility,
+		ContentHash:  signature.ContentHash,
+		Status:       h.initialFileStatus(),
+		UploadedBy:   signature.UploadedBy,
 	}
 
 	fileID, err := h.FileRepository.CreateFileRecord(c.Request.Context(), fileInput)
@@ -82,12 +143,25 @@ func (h *Handler) ConfirmUpload(c *gin.Context) {
 		return
 	}
 
+	// Tarayıcı tanımlıysa dosya taranana kadar karantinada kalır, listelenmez ve adresi verilmez
+	h.scheduleScan(types.File{
+		ID:           fileID,
+		ObjectKey:    objectKey,
+		Filename:     fileInput.Filename,
+		FileCategory: fileInput.FileCategory,
+		SizeInBytes:  sizeInBytes,
+		Visibility:   signature.Visibility,
+		Status:       string(fileInput.Status),
+	})
+
 	// Başarılı yanıt döndür
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"data": gin.H{
-			"id":  fileID.String(),
-			"url": input.URL,
+			"id":         fileID.String(),
+			"url":        h.uploadedFileURL(fileID, objectKey, signature.Visibility, fileInput.Status),
+			"visibility": signature.Visibility,
+			"status":     fileInput.Status,
 		},
 	})
 }
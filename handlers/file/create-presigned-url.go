@@ -3,6 +3,7 @@ package FileHandler
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/okanay/backend-template/types"
@@ -15,12 +16,54 @@ func (h *Handler) CreatePresignedURL(c *gin.Context) {
 		return
 	}
 
+	policy := h.getFileCategoryPolicy(c, input.FileCategory)
+	if policy == nil || !h.checkFileAllowed(c, policy, input.Filename, input.ContentType, input.SizeInBytes) {
+		return
+	}
+
+	// Aynı içerik bu kategoride daha önce yüklendiyse yeni URL üretmeden mevcut dosyayı döndür
+	contentHash := strings.ToLower(input.ContentHash)
+	if contentHash != "" {
+		existing, err := h.FileRepository.GetFileByContentHash(c.Request.Context(), contentHash, policy.Name)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   "files_fetch_failed",
+				"message": "Mevcut dosyalar kontrol edilemedi: " + err.Error(),
+			})
+			return
+		}
+
+		if existing != nil {
+			c.JSON(http.StatusOK, gin.H{
+				"success": true,
+				"data": types.CreatePresignedURLResponse{
+					Filename:  existing.Filename,
+					Duplicate: true,
+					File: &types.FileResponse{
+						ID:         existing.ID.String(),
+						URL:        h.fileAccessURL(existing.ID, existing.ObjectKey, existing.Visibility),
+						Visibility: existing.Visibility,
+					},
+				},
+			})
+			return
+		}
+	}
+
+	// Kullanıcının kotasında yer yoksa imza verilmez. İmza, süresi dolana kadar kotadan yer ayırır.
+	if !h.checkStorageQuota(c, policy.Name, input.SizeInBytes) {
+		return
+	}
+
 	// Presigned URL oluştur
-	presignedOutput, err := h.R2Repository.GeneratePresignedURL(c.Request.Context(), types.PresignURLInput{
+	presignedOutput, err := h.StorageService.GeneratePresignedURL(c.Request.Context(), types.PresignURLInput{
 		Filename:     input.Filename,
 		ContentType:  input.ContentType,
-		FileCategory: input.FileCategory,
+		FileCategory: policy.Name,
 		SizeInBytes:  input.SizeInBytes,
+		Visibility:   h.storageVisibility(policy.Visibility),
+		ContentHash:  contentHash,
 	})
 
 	if err != nil {
@@ -33,12 +76,18 @@ func (h *Handler) CreatePresignedURL(c *gin.Context) {
 	}
 
 	// Veritabanında signature kaydı oluştur
+	userID, _ := currentUser(c)
 	signatureInput := types.UploadSignatureInput{
 		PresignedURL: presignedOutput.PresignedURL,
 		UploadURL:    presignedOutput.UploadURL,
+		ObjectKey:    presignedOutput.ObjectKey,
 		Filename:     input.Filename,
 		FileType:     input.ContentType,
-		FileCategory: input.FileCategory,
+		FileCategory: policy.Name,
+		Visibility:   policy.Visibility,
+		ContentHash:  optionalString(contentHash),
+		SizeInBytes:  input.SizeInBytes,
+		UploadedBy:   userID,
 		ExpiresAt:    presignedOutput.ExpiresAt,
 	}
 
@@ -59,6 +108,7 @@ func (h *Handler) CreatePresignedURL(c *gin.Context) {
 			ID:           signatureID.String(),
 			PresignedURL: presignedOutput.PresignedURL,
 			UploadURL:    presignedOutput.UploadURL,
+			Headers:      presignedOutput.Headers,
 			ExpiresAt:    presignedOutput.ExpiresAt,
 			Filename:     input.Filename,
 		},
@@ -0,0 +1,98 @@
+// handlers/file/delete-file.go
+package FileHandler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DeleteFile bir dosyayı çöp kutusuna taşır.
+// Storage üzerindeki nesne saklama süresi dolana kadar korunur, böylece dosya geri yüklenebilir.
+func (h *Handler) DeleteFile(c *gin.Context) {
+	// Dosya ID'sini al
+	fileIDStr := c.Param("id")
+	fileID, err := uuid.Parse(fileIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_file_id",
+			"message": "Geçersiz dosya ID'si",
+		})
+		return
+	}
+
+	// Dosya bilgilerini getir
+	file, err := h.FileRepository.GetFileByID(c.Request.Context(), fileID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_fetch_failed",
+			"message": "Dosya bilgileri getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	if file == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "file_not_found",
+			"message": "Dosya bulunamadı",
+		})
+		return
+	}
+
+	// Private kategorilerdeki dosyalar yalnızca kategorinin ek yetkisine sahip kullanıcılar tarafından silinebilir
+	if !h.checkCategoryAccess(c, file.FileCategory) {
+		return
+	}
+
+	// Dosya hâlâ içeriklerde veya kullanıcı avatarlarında kullanılıyorsa, açıkça istenmedikçe silme
+	references, err := h.FileRepository.GetFileReferences(c.Request.Context(), file.ObjectKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "references_fetch_failed",
+			"message": "Dosya referansları kontrol edilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	if len(references) > 0 && c.Query("force") != "true" {
+		c.JSON(http.StatusConflict, gin.H{
+			"success":    false,
+			"error":      "file_in_use",
+			"message":    "Dosya hâlâ kullanılıyor. Yine de silmek için force=true gönderin.",
+			"references": references,
+		})
+		return
+	}
+
+	// Dosyayı çöp kutusuna taşı
+	err = h.FileRepository.DeleteFile(c.Request.Context(), fileID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "file_not_found",
+				"message": "Dosya bulunamadı",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_delete_failed",
+			"message": "Dosya silinemedi: " + err.Error(),
+		})
+		return
+	}
+
+	// Başarılı yanıt döndür
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Dosya çöp kutusuna taşındı",
+	})
+}
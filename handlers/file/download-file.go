@@ -0,0 +1,64 @@
+// handlers/file/download-file.go
+package FileHandler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+)
+
+// DownloadFile dosya için kısa ömürlü imzalı bir indirme URL'si üretir ve istemciyi oraya yönlendirir.
+// Private dosyalara yalnızca bu endpoint üzerinden erişilebilir.
+func (h *Handler) DownloadFile(c *gin.Context) {
+	fileID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_file_id",
+			"message": "Geçersiz dosya ID'si",
+		})
+		return
+	}
+
+	file, err := h.FileRepository.GetFileByID(c.Request.Context(), fileID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_fetch_failed",
+			"message": "Dosya bilgileri getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	if file == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "file_not_found",
+			"message": "Dosya bulunamadı",
+		})
+		return
+	}
+
+	// Kategori ek yetki gerektiriyorsa (örn. özgeçmişler) indirme için de aranır
+	if !h.checkCategoryAccess(c, file.FileCategory) {
+		return
+	}
+
+	// İstemci farklı bir dosya adı istemediyse orijinal adı kullan
+	downloadFilename := c.DefaultQuery("filename", file.Filename)
+
+	objectKey := file.ObjectKey
+	downloadURL, err := h.StorageService.GeneratePresignedGetURL(c.Request.Context(), objectKey, configs.FILE_DOWNLOAD_URL_DURATION, downloadFilename)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "download_url_failed",
+			"message": "İndirme URL'si oluşturulamadı: " + err.Error(),
+		})
+		return
+	}
+
+	c.Redirect(http.StatusFound, downloadURL)
+}
@@ -0,0 +1,31 @@
+// handlers/file/get-file-categories.go
+package FileHandler
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
+)
+
+// GetFileCategories tanımlı dosya kategorilerini ve kurallarını listeler.
+// Frontend, yükleme öncesi doğrulama ve kullanıcıya gösterilecek kategoriler için bu listeyi kullanır.
+func (h *Handler) GetFileCategories(c *gin.Context) {
+	categories := make([]gin.H, 0, len(configs.FileCategoryPolicies))
+	for _, name := range slices.Sorted(maps.Keys(configs.FileCategoryPolicies)) {
+		policy := configs.FileCategoryPolicies[name]
+		policy.MaxSize = h.maxUploadSize(policy) // Tarayıcı tanımlıysa tarama sınırı da yansıtılır
+		categories = append(categories, gin.H{
+			"policy":  policy,
+			"allowed": canAccessCategory(c, name),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":         true,
+		"defaultCategory": configs.FILE_DEFAULT_CATEGORY,
+		"data":            categories,
+	})
+}
@@ -0,0 +1,80 @@
+// handlers/file/get-file-references.go
+package FileHandler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetFileReferences bir dosyanın hangi içerik dosyalarında ve hangi kullanıcı avatarlarında kullanıldığını listeler
+func (h *Handler) GetFileReferences(c *gin.Context) {
+	fileID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_file_id",
+			"message": "Geçersiz dosya ID'si",
+		})
+		return
+	}
+
+	file, err := h.FileRepository.GetFileByID(c.Request.Context(), fileID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_fetch_failed",
+			"message": "Dosya bilgileri getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	if file == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "file_not_found",
+			"message": "Dosya bulunamadı",
+		})
+		return
+	}
+
+	// Private kategorilerdeki dosyaların nerede kullanıldığı da kategorinin ek yetkisi olmadan gösterilmez
+	if !h.checkCategoryAccess(c, file.FileCategory) {
+		return
+	}
+
+	references, err := h.FileRepository.GetFileReferences(c.Request.Context(), file.ObjectKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "references_fetch_failed",
+			"message": "Dosya referansları getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	if references == nil {
+		references = []types.FileReference{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    references,
+	})
+}
+
+// RebuildAvatarReferences kullanıcı avatarlarından kaynaklanan dosya referanslarını yeniden oluşturur.
+// AutomationService tarafından periyodik olarak çağrılır.
+func (h *Handler) RebuildAvatarReferences(ctx context.Context) {
+	count, err := h.FileRepository.RebuildAvatarFileReferences(ctx)
+	if err != nil {
+		log.Printf("[FILES] Avatar referansları yeniden oluşturulamadı: %v", err)
+		return
+	}
+
+	log.Printf("[FILES] %d avatar referansı indekslendi.", count)
+}
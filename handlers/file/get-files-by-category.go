@@ -20,8 +20,13 @@ func (h *Handler) GetFilesByCategory(c *gin.Context) {
 		return
 	}
 
+	policy := h.getFileCategoryPolicy(c, category)
+	if policy == nil {
+		return
+	}
+
 	// Dosyaları getir
-	files, err := h.FileRepository.GetFilesByCategory(c.Request.Context(), category)
+	files, err := h.FileRepository.GetFilesByCategory(c.Request.Context(), policy.Name)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -36,11 +41,13 @@ func (h *Handler) GetFilesByCategory(c *gin.Context) {
 	for _, file := range files {
 		response = append(response, gin.H{
 			"id":           file.ID.String(),
-			"url":          file.URL,
+			"url":          h.fileAccessURL(file.ID, file.ObjectKey, file.Visibility),
 			"filename":     file.Filename,
 			"fileType":     file.FileType,
 			"fileCategory": file.FileCategory,
 			"sizeInBytes":  file.SizeInBytes,
+			"visibility":   file.Visibility,
+			"tags":         file.Tags,
 			"createdAt":    file.CreatedAt,
 		})
 	}
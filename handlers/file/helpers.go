@@ -0,0 +1,240 @@
+// handlers/file/helpers.go
+package FileHandler
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// getFileCategoryPolicy kategorinin tanımlı olup olmadığını ve kullanıcının bu kategoriye erişim yetkisini kontrol eder.
+// Kategori tanımlı değilse 400, yetki yoksa 403 yanıtı döner ve nil döndürür.
+func (h *Handler) getFileCategoryPolicy(c *gin.Context, category string) *types.FileCategoryPolicy {
+	policy, ok := configs.GetFileCategoryPolicy(category)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_file_category",
+			"message": "Geçersiz dosya kategorisi: " + category,
+		})
+		return nil
+	}
+
+	if !h.checkCategoryAccess(c, policy.Name) {
+		return nil
+	}
+
+	return &policy
+}
+
+// checkCategoryAccess kullanıcının kategorinin gerektirdiği ek yetkiye sahip olup olmadığını kontrol eder.
+// Yetki yoksa 403 yanıtı döner ve false döndürür.
+func (h *Handler) checkCategoryAccess(c *gin.Context, category string) bool {
+	if canAccessCategory(c, category) {
+		return true
+	}
+
+	policy, _ := configs.GetFileCategoryPolicy(category)
+	c.JSON(http.StatusForbidden, gin.H{
+		"success":             false,
+		"error":               "insufficient_permissions",
+		"message":             "Bu kategorideki dosyalar için yetkiniz bulunmamaktadır.",
+		"required_permission": policy.RequiredPermission,
+	})
+	return false
+}
+
+// canAccessCategory kategori ek yetki gerektirmiyorsa veya kullanıcı bu yetkiye sahipse true döndürür.
+// Registry'de olmayan (eski) kategoriler için ek yetki aranmaz.
+func canAccessCategory(c *gin.Context, category string) bool {
+	policy, ok := configs.GetFileCategoryPolicy(category)
+	return !ok || policy.RequiredPermission == "" || hasPermission(c, policy.RequiredPermission)
+}
+
+// checkFileAllowed dosya tipinin ve boyutunun kategori kurallarına uyup uymadığını kontrol eder.
+// Kurallara uymuyorsa 400 yanıtı döner ve false döndürür.
+func (h *Handler) checkFileAllowed(c *gin.Context, policy *types.FileCategoryPolicy, filename, contentType string, sizeInBytes int64) bool {
+	if !configs.IsFileTypeAllowed(*policy, filename, contentType) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success":           false,
+			"error":             "file_type_not_allowed",
+			"message":           fmt.Sprintf("Bu dosya tipi '%s' kategorisi için kabul edilmiyor", policy.Name),
+			"allowedMimeTypes":  policy.AllowedMimeTypes,
+			"allowedExtensions": policy.AllowedExtensions,
+		})
+		return false
+	}
+
+	if maxSize := h.maxUploadSize(*policy); sizeInBytes > maxSize {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "file_too_large",
+			"message": fmt.Sprintf("Dosya boyutu bu kategori için izin verilen %d byte sınırını aşıyor", maxSize),
+		})
+		return false
+	}
+
+	return true
+}
+
+// hasPermission kullanıcının verilen izne sahip olup olmadığını döndürür. Admin her zaman yetkilidir.
+// İzinler PermissionMiddleware tarafından context'e yazılır.
+func hasPermission(c *gin.Context, permission types.Permission) bool {
+	roleVal, _ := c.Get("user_role")
+	if role, ok := roleVal.(types.Role); ok && role == types.RoleAdmin {
+		return true
+	}
+
+	permissions, _ := c.Get("user_permissions")
+	userPermissions, _ := permissions.([]types.Permission)
+	return slices.Contains(userPermissions, permission)
+}
+
+// fileAccessURL istemciye döndürülecek erişim adresini belirler.
+// Private dosyaların CDN adresi erişilemez olduğundan, imzalı URL üreten indirme endpoint'i döndürülür.
+func (h *Handler) fileAccessURL(fileID uuid.UUID, objectKey string, visibility types.FileVisibility) string {
+	if visibility == types.FileVisibilityPrivate {
+		return "/v1/files/" + fileID.String() + "/download"
+	}
+	return h.publicFileURL(objectKey)
+}
+
+// publicFileURL nesne anahtarından public URL'yi üretir.
+// URL veritabanından okunmaz; böylece CDN adresi değiştiğinde kayıtları güncellemek gerekmez.
+func (h *Handler) publicFileURL(objectKey string) string {
+	if base := configs.GetFileCDNURLBase(); base != "" {
+		return base + "/" + objectKey
+	}
+	return h.StorageService.PublicURL(objectKey)
+}
+
+// signatureObjectKey imza kaydına ait nesne anahtarını döndürür.
+// object_key kolonundan önce oluşturulan imzalarda anahtar yükleme URL'sinden çıkarılır.
+func (h *Handler) signatureObjectKey(signature *types.UploadSignature) string {
+	if signature.ObjectKey != nil && *signature.ObjectKey != "" {
+		return *signature.ObjectKey
+	}
+	return h.StorageService.ObjectKeyFromURL(signature.UploadURL)
+}
+
+// headUploadedObject yüklenen nesnenin storage'daki bilgilerini getirir.
+// Nesne alınamazsa veya bulunamazsa uygun yanıtı döner ve nil döndürür.
+func (h *Handler) headUploadedObject(c *gin.Context, objectKey string) *types.ObjectInfo {
+	info, err := h.StorageService.HeadObject(c.Request.Context(), objectKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "object_fetch_failed",
+			"message": "Yüklenen dosya bilgileri alınamadı: " + err.Error(),
+		})
+		return nil
+	}
+
+	if info == nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "object_not_found",
+			"message": "Yüklenen dosya storage üzerinde bulunamadı",
+		})
+		return nil
+	}
+
+	return info
+}
+
+// verifyContentHash yüklenen nesnenin SHA-256 özetini imza kaydındaki özetle karşılaştırır.
+// Özet uyuşmazsa nesne silinir, uygun yanıt döner ve false döndürür.
+func (h *Handler) verifyContentHash(c *gin.Context, objectKey string, info *types.ObjectInfo, contentHash string) bool {
+	if info.ChecksumSHA256 != contentHash {
+		h.StorageService.DeleteObject(c.Request.Context(), objectKey)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "content_hash_mismatch",
+			"message": "Yüklenen dosyanın içerik özeti bildirilen özetle eşleşmiyor",
+		})
+		return false
+	}
+
+	return true
+}
+
+// optionalString boş string'i veritabanına NULL olarak yazılması için nil'e çevirir
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// getPendingMultipartUpload URL'deki ID'ye ait, hâlâ devam eden çok parçalı yükleme kaydını getirir.
+// Kayıt bulunamazsa, başka bir kullanıcıya aitse (adminler hariç), tamamlanmış/iptal edilmişse veya
+// süresi dolmuşsa uygun yanıtı döner ve nil döndürür.
+func (h *Handler) getPendingMultipartUpload(c *gin.Context) *types.MultipartUpload {
+	uploadID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_upload_id",
+			"message": "Geçersiz yükleme ID'si",
+		})
+		return nil
+	}
+
+	upload, err := h.FileRepository.GetMultipartUploadByID(c.Request.Context(), uploadID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "upload_fetch_failed",
+			"message": "Yükleme bilgileri alınamadı: " + err.Error(),
+		})
+		return nil
+	}
+
+	// Başka bir kullanıcının yüklemesi, varlığı belli edilmeden bulunamadı olarak yanıtlanır
+	userID, role := currentUser(c)
+	if upload == nil || (role != types.RoleAdmin && (upload.UploadedBy == nil || *upload.UploadedBy != userID)) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "upload_not_found",
+			"message": "Yükleme kaydı bulunamadı",
+		})
+		return nil
+	}
+
+	if upload.Status != types.MultipartUploadStatusPending {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error":   "upload_not_pending",
+			"message": "Bu yükleme zaten " + string(upload.Status) + " durumunda",
+		})
+		return nil
+	}
+
+	if time.Now().After(upload.ExpiresAt) {
+		c.JSON(http.StatusGone, gin.H{
+			"success": false,
+			"error":   "upload_expired",
+			"message": "Yükleme süresi dolmuş",
+		})
+		return nil
+	}
+
+	return upload
+}
+
+// calculatePartLayout toplam boyuta göre parça boyutunu ve parça sayısını hesaplar.
+// Parça sayısı S3 sınırını aşacaksa parça boyutu büyütülür.
+func calculatePartLayout(sizeInBytes int64) (partSize int64, partCount int32) {
+	partSize = configs.FILE_MULTIPART_PART_SIZE
+	if sizeInBytes > partSize*configs.FILE_MULTIPART_MAX_PARTS {
+		partSize = (sizeInBytes + configs.FILE_MULTIPART_MAX_PARTS - 1) / configs.FILE_MULTIPART_MAX_PARTS
+	}
+	partCount = int32((sizeInBytes + partSize - 1) / partSize)
+	return partSize, partCount
+}
@@ -2,21 +2,31 @@
 package FileHandler
 
 import (
+	"sync"
+
+	"github.com/okanay/backend-template/configs"
 	FileRepository "github.com/okanay/backend-template/repositories/file"
-	R2Repository "github.com/okanay/backend-template/services/r2"
+	"github.com/okanay/backend-template/services/scanner"
+	"github.com/okanay/backend-template/services/storage"
 	ValidationService "github.com/okanay/backend-template/services/validation"
 )
 
 type Handler struct {
 	FileRepository    *FileRepository.Repository
-	R2Repository      *R2Repository.Service
+	StorageService    storage.Storage
+	ScannerService    scanner.Scanner // nil ise yüklenen dosyalar taranmaz
 	ValidationService *ValidationService.Service
+
+	scanSemaphore chan struct{}
+	scanning      sync.Map // Taraması süren dosya ID'leri; aynı dosyanın iki kez taranmasını engeller
 }
 
-func NewHandler(f *FileRepository.Repository, r2 *R2Repository.Service, validationService *ValidationService.Service) *Handler {
+func NewHandler(f *FileRepository.Repository, storageService storage.Storage, scannerService scanner.Scanner, validationService *ValidationService.Service) *Handler {
 	return &Handler{
 		FileRepository:    f,
-		R2Repository:      r2,
+		StorageService:    storageService,
+		ScannerService:    scannerService,
 		ValidationService: validationService,
+		scanSemaphore:     make(chan struct{}, configs.FILE_SCAN_WORKERS),
 	}
 }
@@ -0,0 +1,114 @@
+// handlers/file/initiate-multipart-upload.go
+package FileHandler
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// InitiateMultipartUpload büyük dosyalar için çok parçalı yükleme başlatır ve parça URL'lerini döndürür
+func (h *Handler) InitiateMultipartUpload(c *gin.Context) {
+	var input types.InitiateMultipartUploadInput
+	if h.ValidationService.Validate(c, &input) != nil {
+		return
+	}
+
+	policy := h.getFileCategoryPolicy(c, input.FileCategory)
+	if policy == nil || !h.checkFileAllowed(c, policy, input.Filename, input.ContentType, input.SizeInBytes) {
+		return
+	}
+
+	// Bekleyen yükleme, tamamlanana veya süresi dolana kadar kotadan yer ayırır
+	if !h.checkStorageQuota(c, policy.Name, input.SizeInBytes) {
+		return
+	}
+
+	// R2 üzerinde multipart upload başlat
+	multipartOutput, err := h.StorageService.CreateMultipartUpload(c.Request.Context(), types.PresignURLInput{
+		Filename:     input.Filename,
+		ContentType:  input.ContentType,
+		FileCategory: policy.Name,
+		SizeInBytes:  input.SizeInBytes,
+		Visibility:   h.storageVisibility(policy.Visibility),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "multipart_initiate_failed",
+			"message": "Çok parçalı yükleme başlatılamadı: " + err.Error(),
+		})
+		return
+	}
+
+	// Tüm parçalar için presigned URL oluştur
+	partSize, partCount := calculatePartLayout(input.SizeInBytes)
+	partNumbers := make([]int32, partCount)
+	for i := range partNumbers {
+		partNumbers[i] = int32(i + 1)
+	}
+
+	parts, urlsExpireAt, err := h.StorageService.PresignUploadParts(c.Request.Context(), multipartOutput.ObjectKey, multipartOutput.UploadID, partNumbers)
+	if err != nil {
+		h.abortMultipartLogged(c.Request.Context(), multipartOutput.ObjectKey, multipartOutput.UploadID)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "presigned_url_failed",
+			"message": "Parça URL'leri oluşturulamadı: " + err.Error(),
+		})
+		return
+	}
+
+	// Veritabanında yükleme kaydı oluştur
+	userID, _ := currentUser(c)
+	id, err := h.FileRepository.CreateMultipartUpload(c.Request.Context(), types.MultipartUploadInput{
+		UploadID:     multipartOutput.UploadID,
+		ObjectKey:    multipartOutput.ObjectKey,
+		UploadURL:    multipartOutput.UploadURL,
+		Filename:     input.Filename,
+		FileType:     input.ContentType,
+		FileCategory: policy.Name,
+		SizeInBytes:  input.SizeInBytes,
+		Visibility:   policy.Visibility,
+		PartSize:     partSize,
+		PartCount:    partCount,
+		UploadedBy:   userID,
+		ExpiresAt:    time.Now().Add(configs.FILE_MULTIPART_UPLOAD_EXPIRY),
+	})
+	if err != nil {
+		h.abortMultipartLogged(c.Request.Context(), multipartOutput.ObjectKey, multipartOutput.UploadID)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "upload_creation_failed",
+			"message": "Yükleme kaydı oluşturulamadı: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": types.InitiateMultipartUploadResponse{
+			ID:        id.String(),
+			UploadID:  multipartOutput.UploadID,
+			UploadURL: multipartOutput.UploadURL,
+			PartSize:  partSize,
+			PartCount: partCount,
+			Parts:     parts,
+			ExpiresAt: urlsExpireAt,
+			Filename:  input.Filename,
+		},
+	})
+}
+
+// abortMultipartLogged, kayıt oluşturulamayan yüklemeyi storage'da iptal eder. Hata loglanır; iptal edilemeyen
+// yüklemeler storage'ın yaşam döngüsü kurallarıyla temizlenene kadar yer kaplar.
+func (h *Handler) abortMultipartLogged(ctx context.Context, objectKey, uploadID string) {
+	if err := h.StorageService.AbortMultipartUpload(ctx, objectKey, uploadID); err != nil {
+		log.Printf("[FILES] Çok parçalı yükleme iptal edilemedi (key: %s): %v", objectKey, err)
+	}
+}
@@ -0,0 +1,62 @@
+// handlers/file/refresh-multipart-parts.go
+package FileHandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/types"
+)
+
+// RefreshMultipartParts süresi dolan parça URL'lerini yeniden oluşturur
+func (h *Handler) RefreshMultipartParts(c *gin.Context) {
+	var input types.RefreshMultipartPartsInput
+	if h.ValidationService.Validate(c, &input) != nil {
+		return
+	}
+
+	upload := h.getPendingMultipartUpload(c)
+	if upload == nil {
+		return
+	}
+
+	// Parça numarası verilmemişse tüm parçaları yenile
+	partNumbers := input.PartNumbers
+	if len(partNumbers) == 0 {
+		partNumbers = make([]int32, upload.PartCount)
+		for i := range partNumbers {
+			partNumbers[i] = int32(i + 1)
+		}
+	}
+
+	for _, partNumber := range partNumbers {
+		if partNumber > upload.PartCount {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "invalid_part_number",
+				"message": fmt.Sprintf("Parça numarası %d, toplam parça sayısını (%d) aşıyor", partNumber, upload.PartCount),
+			})
+			return
+		}
+	}
+
+	parts, expiresAt, err := h.StorageService.PresignUploadParts(c.Request.Context(), upload.ObjectKey, upload.UploadID, partNumbers)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "presigned_url_failed",
+			"message": "Parça URL'leri oluşturulamadı: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"id":        upload.ID.String(),
+			"parts":     parts,
+			"expiresAt": expiresAt,
+		},
+	})
+}
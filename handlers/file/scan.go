@@ -0,0 +1,250 @@
+// handlers/file/scan.go
+package FileHandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/services/scanner"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// initialFileStatus yeni oluşturulan dosya kaydının durumunu belirler.
+// Tarayıcı tanımlıysa dosya taraması bitene kadar karantinada kalır.
+func (h *Handler) initialFileStatus() types.FileRecordStatus {
+	if h.ScannerService != nil {
+		return types.FileRecordStatusQuarantined
+	}
+	return types.FileRecordStatusActive
+}
+
+// storageVisibility nesnenin storage'da hangi önek altına yükleneceğini belirler. Tarayıcı tanımlıysa nesne,
+// tarama sonucu gelmeden CDN'den sunulmasın diye private önek altına yüklenir; temiz çıkarsa scanFile
+// tarafından kategorinin görünürlüğüne taşınır.
+func (h *Handler) storageVisibility(visibility types.FileVisibility) types.FileVisibility {
+	if h.ScannerService != nil {
+		return types.FileVisibilityPrivate
+	}
+	return visibility
+}
+
+// maxUploadSize kategoriye yüklenebilecek en büyük dosya boyutunu döndürür. Tarayıcı tanımlıysa tarama sınırını
+// aşan dosyalar hiç aktif olamayacağından sınır FILE_SCAN_MAX_SIZE_MB ile kısıtlanır.
+func (h *Handler) maxUploadSize(policy types.FileCategoryPolicy) int64 {
+	if scanMaxSize := configs.GetFileScanMaxSize(); h.ScannerService != nil && scanMaxSize > 0 {
+		return min(policy.MaxSize, scanMaxSize)
+	}
+	return policy.MaxSize
+}
+
+// uploadedFileURL yeni yüklenen dosyanın erişim adresini döndürür. Karantinadaki dosyanın nesnesi
+// tarama sonrasında taşınacağından adres dosya aktif olana kadar verilmez.
+func (h *Handler) uploadedFileURL(fileID uuid.UUID, objectKey string, visibility types.FileVisibility, status types.FileRecordStatus) string {
+	if status != types.FileRecordStatusActive {
+		return ""
+	}
+	return h.fileAccessURL(fileID, objectKey, visibility)
+}
+
+// scheduleScan karantinadaki dosyanın taramasını arka planda başlatır
+func (h *Handler) scheduleScan(file types.File) {
+	if h.ScannerService == nil || types.FileRecordStatus(file.Status) != types.FileRecordStatusQuarantined {
+		return
+	}
+	go h.scanFile(context.Background(), file)
+}
+
+// ScanQuarantinedFiles taraması yarıda kalmış veya hata vermiş karantinadaki dosyaları yeniden tarar.
+// Sunucu yeniden başladığında bekleyen taramalar da bu görevle tamamlanır.
+func (h *Handler) ScanQuarantinedFiles(ctx context.Context) {
+	if h.ScannerService == nil {
+		return
+	}
+
+	files, err := h.FileRepository.GetQuarantinedFiles(ctx, time.Now().Add(-configs.FILE_SCAN_RETRY_DELAY))
+	if err != nil {
+		log.Printf("[FILES] Karantinadaki dosyalar getirilemedi: %v", err)
+		return
+	}
+
+	for _, file := range files {
+		h.scanFile(ctx, file)
+	}
+}
+
+// scanFile dosyayı storage'dan okuyarak tarar.
+// Temiz dosyalar public önekteki asıl yerlerine taşınıp aktif hale getirilir; zararlı dosyaların nesnesi ve kaydı silinir. Her sonuç kayıt altına alınır.
+// Tarama hata verirse dosya karantinada kalır ve ScanQuarantinedFiles tarafından tekrar denenir. Tarayıcının boyut
+// sınırını aşan veya FILE_SCAN_MAX_ATTEMPTS kez hata veren dosyalar scan_failed durumuna alınır ve tekrar denenmez.
+func (h *Handler) scanFile(ctx context.Context, file types.File) {
+	if _, running := h.scanning.LoadOrStore(file.ID, true); running {
+		return
+	}
+	defer h.scanning.Delete(file.ID)
+
+	h.scanSemaphore <- struct{}{}
+	defer func() { <-h.scanSemaphore }()
+
+	ctx, cancel := context.WithTimeout(ctx, configs.FILE_SCAN_TIMEOUT)
+	defer cancel()
+
+	event := types.FileScanEvent{
+		FileID:       file.ID,
+		ObjectKey:    file.ObjectKey,
+		Filename:     file.Filename,
+		FileCategory: file.FileCategory,
+		Scanner:      h.ScannerService.Name(),
+	}
+
+	// Sınırı aşan dosyalar tarayıcıya hiç gönderilmez; her denemede nesnenin tamamı boşuna indirilmiş olurdu
+	if maxSize := configs.GetFileScanMaxSize(); maxSize > 0 && file.SizeInBytes > maxSize {
+		err := fmt.Errorf("%w: %d bytes, limit %d bytes", scanner.ErrContentTooLarge, file.SizeInBytes, maxSize)
+		h.recordScanError(event, err)
+		return
+	}
+
+	result, err := h.scanObject(ctx, file.ObjectKey)
+	if err != nil {
+		h.recordScanError(event, err)
+		return
+	}
+
+	if !result.Infected {
+		if !h.activateScannedFile(ctx, file) {
+			return
+		}
+		event.Result = types.FileScanResultClean
+		h.recordScanEvent(event)
+		return
+	}
+
+	log.Printf("[FILES] Zararlı dosya tespit edildi (id: %s, key: %s, imza: %s)", file.ID, file.ObjectKey, result.Signature)
+	event.Result = types.FileScanResultInfected
+	event.Signature = &result.Signature
+	h.recordScanEvent(event)
+
+	if err := h.StorageService.DeleteObject(ctx, file.ObjectKey); err != nil {
+		log.Printf("[FILES] Zararlı dosyanın nesnesi silinemedi (key: %s): %v", file.ObjectKey, err)
+		return
+	}
+	if err := h.FileRepository.PurgeFile(ctx, file.ID); err != nil {
+		log.Printf("[FILES] Zararlı dosyanın kaydı silinemedi (id: %s): %v", file.ID, err)
+	}
+}
+
+// activateScannedFile temiz çıkan dosyanın nesnesini private önekten kategorinin görünürlüğüne uygun anahtara
+// kopyalar ve kaydı aktif hale getirir. Bir adım başarısız olursa dosya karantinada kalır, tekrar taranır ve false döner.
+func (h *Handler) activateScannedFile(ctx context.Context, file types.File) bool {
+	oldKey := file.ObjectKey
+	newKey := utils.RelocateObjectKey(oldKey, file.FileCategory, file.Visibility)
+	if newKey != oldKey {
+		if err := h.StorageService.CopyObject(ctx, oldKey, newKey); err != nil {
+			log.Printf("[FILES] Taranan dosya yayın konumuna kopyalanamadı (id: %s): %v", file.ID, err)
+			return false
+		}
+	}
+
+	if err := h.FileRepository.ActivateQuarantinedFile(ctx, file.ID, newKey, h.publicFileURL(newKey)); err != nil {
+		log.Printf("[FILES] Taranan dosya aktif hale getirilemedi (id: %s): %v", file.ID, err)
+		if newKey != oldKey {
+			h.StorageService.DeleteObject(ctx, newKey)
+		}
+		return false
+	}
+
+	if newKey != oldKey {
+		if err := h.StorageService.DeleteObject(ctx, oldKey); err != nil {
+			log.Printf("[FILES] Karantinadaki nesne silinemedi (key: %s): %v", oldKey, err)
+		}
+	}
+	return true
+}
+
+// recordScanError tarama hatasını kaydeder. Hata tekrar denemeyle düzelmeyecekse veya deneme sınırına
+// ulaşıldıysa dosya scan_failed durumuna alınır.
+func (h *Handler) recordScanError(event types.FileScanEvent, scanErr error) {
+	// Taramanın süresi dolmuş olabileceğinden kayıt işlemleri taramanın context'inden bağımsızdır
+	ctx := context.Background()
+	log.Printf("[FILES] Dosya taranamadı (id: %s): %v", event.FileID, scanErr)
+	message := scanErr.Error()
+	event.Result = types.FileScanResultError
+	event.Message = &message
+	h.recordScanEvent(event)
+
+	if !errors.Is(scanErr, scanner.ErrContentTooLarge) {
+		attempts, err := h.FileRepository.CountFileScanErrors(ctx, event.FileID)
+		if err != nil {
+			log.Printf("[FILES] Tarama denemeleri sayılamadı (id: %s): %v", event.FileID, err)
+			return
+		}
+		if attempts < configs.FILE_SCAN_MAX_ATTEMPTS {
+			return
+		}
+	}
+
+	if err := h.FileRepository.MarkFileScanFailed(ctx, event.FileID); err != nil {
+		log.Printf("[FILES] Taranamayan dosyanın durumu güncellenemedi (id: %s): %v", event.FileID, err)
+		return
+	}
+	log.Printf("[FILES] Dosya taranamadığı için scan_failed durumuna alındı (id: %s)", event.FileID)
+}
+
+func (h *Handler) scanObject(ctx context.Context, objectKey string) (*types.ScanResult, error) {
+	content, err := h.StorageService.GetObject(ctx, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	return h.ScannerService.Scan(ctx, content)
+}
+
+func (h *Handler) recordScanEvent(event types.FileScanEvent) {
+	if err := h.FileRepository.CreateFileScanEvent(context.Background(), event); err != nil {
+		log.Printf("[FILES] Tarama kaydı oluşturulamadı (id: %s): %v", event.FileID, err)
+	}
+}
+
+// GetFileScanEvents tarama kayıtlarını listeler. "result" parametresiyle clean/infected/error filtrelenebilir.
+func (h *Handler) GetFileScanEvents(c *gin.Context) {
+	result := c.Query("result")
+	switch types.FileScanResult(result) {
+	case "", types.FileScanResultClean, types.FileScanResultInfected, types.FileScanResultError:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_scan_result",
+			"message": "Geçersiz tarama sonucu filtresi",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit < 1 || limit > 1000 {
+		limit = 100
+	}
+
+	events, err := h.FileRepository.GetFileScanEvents(c.Request.Context(), result, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "scan_events_fetch_failed",
+			"message": "Tarama kayıtları getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    events,
+	})
+}
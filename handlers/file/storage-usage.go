@@ -0,0 +1,142 @@
+// handlers/file/storage-usage.go
+package FileHandler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetStorageUsage oturumdaki kullanıcının depolama kullanımını ve rolüne göre sınırlarını döndürür
+func (h *Handler) GetStorageUsage(c *gin.Context) {
+	userID, role := currentUser(c)
+
+	usage, err := h.FileRepository.GetStorageUsage(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "usage_fetch_failed",
+			"message": "Depolama kullanımı getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"usage": usage,
+			"quota": configs.GetFileQuota(role),
+		},
+	})
+}
+
+// GetStorageUsageBreakdown tüm kullanıcıların kategori bazında depolama kullanımını listeler (admin)
+func (h *Handler) GetStorageUsageBreakdown(c *gin.Context) {
+	usages, err := h.FileRepository.GetStorageUsageBreakdown(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "usage_fetch_failed",
+			"message": "Depolama kullanımı getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	// Kategori toplamları, tüm kullanıcıların kullanımından hesaplanır
+	categoryTotals := map[string]*types.FileCategoryUsage{}
+	for _, usage := range usages {
+		for _, category := range usage.Categories {
+			total, ok := categoryTotals[category.Category]
+			if !ok {
+				total = &types.FileCategoryUsage{Category: category.Category}
+				categoryTotals[category.Category] = total
+			}
+			total.UsedBytes += category.UsedBytes
+			total.ReservedBytes += category.ReservedBytes
+			total.FileCount += category.FileCount
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"users":      usages,
+			"categories": categoryTotals,
+		},
+	})
+}
+
+// checkStorageQuota, kullanıcının verilen boyutta yeni bir dosya için kotasında yer olup olmadığını kontrol eder.
+// Sınır aşılıyorsa uygun yanıt döner ve false döndürür.
+func (h *Handler) checkStorageQuota(c *gin.Context, category string, sizeInBytes int64) bool {
+	userID, role := currentUser(c)
+	quota := configs.GetFileQuota(role)
+	categoryLimit := quota.CategoryBytes[category]
+	if quota.TotalBytes == 0 && categoryLimit == 0 {
+		return true
+	}
+
+	usage, err := h.FileRepository.GetStorageUsage(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "usage_fetch_failed",
+			"message": "Depolama kullanımı kontrol edilemedi: " + err.Error(),
+		})
+		return false
+	}
+
+	if quota.TotalBytes > 0 && usage.UsedBytes+usage.ReservedBytes+sizeInBytes > quota.TotalBytes {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success":    false,
+			"error":      "storage_quota_exceeded",
+			"message":    fmt.Sprintf("Depolama kotanız (%d byte) bu dosya için yeterli değil", quota.TotalBytes),
+			"usedBytes":  usage.UsedBytes + usage.ReservedBytes,
+			"limitBytes": quota.TotalBytes,
+		})
+		return false
+	}
+
+	if categoryLimit > 0 && usage.CategoryBytes(category)+sizeInBytes > categoryLimit {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success":    false,
+			"error":      "category_quota_exceeded",
+			"message":    fmt.Sprintf("'%s' kategorisi için depolama kotanız (%d byte) bu dosya için yeterli değil", category, categoryLimit),
+			"usedBytes":  usage.CategoryBytes(category),
+			"limitBytes": categoryLimit,
+		})
+		return false
+	}
+
+	return true
+}
+
+// ReleaseExpiredReservations süresi dolmuş ve tamamlanmamış imza kayıtlarını temizler.
+// Süresi dolan imzalar kota hesabına zaten katılmaz; bu görev yalnızca tabloyu küçük tutar.
+func (h *Handler) ReleaseExpiredReservations(ctx context.Context) {
+	deleted, err := h.FileRepository.DeleteExpiredUploadSignatures(ctx, time.Now().Add(-configs.FILE_SIGNATURE_RETENTION))
+	if err != nil {
+		log.Printf("[FILES] Süresi dolmuş imzalar temizlenemedi: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.Printf("[FILES] %d süresi dolmuş imza kaydı temizlendi", deleted)
+	}
+}
+
+// currentUser oturumdaki kullanıcının ID'sini ve rolünü döndürür
+func currentUser(c *gin.Context) (uuid.UUID, types.Role) {
+	userIDVal, _ := c.Get("user_id")
+	roleVal, _ := c.Get("user_role")
+	userID, _ := userIDVal.(uuid.UUID)
+	role, _ := roleVal.(types.Role)
+	return userID, role
+}
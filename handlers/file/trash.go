@@ -0,0 +1,235 @@
+// handlers/file/trash.go
+package FileHandler
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetTrashedFiles çöp kutusundaki dosyaları listeler. "c" parametresi ile kategoriye göre filtrelenebilir.
+func (h *Handler) GetTrashedFiles(c *gin.Context) {
+	category := c.Query("c")
+	if category != "" && h.getFileCategoryPolicy(c, category) == nil {
+		return
+	}
+
+	files, err := h.FileRepository.GetTrashedFiles(c.Request.Context(), category)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "files_fetch_failed",
+			"message": "Çöp kutusu getirilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	retention := configs.GetFileTrashRetention()
+
+	response := []gin.H{}
+	for _, file := range files {
+		// Kullanıcının yetkisi olmayan kategorilerdeki dosyalar listelenmez
+		if !canAccessCategory(c, file.FileCategory) {
+			continue
+		}
+
+		var purgeAt *time.Time
+		if file.DeletedAt != nil {
+			at := file.DeletedAt.Add(retention)
+			purgeAt = &at
+		}
+
+		response = append(response, gin.H{
+			"id":           file.ID.String(),
+			"url":          h.fileAccessURL(file.ID, file.ObjectKey, file.Visibility),
+			"filename":     file.Filename,
+			"fileType":     file.FileType,
+			"fileCategory": file.FileCategory,
+			"sizeInBytes":  file.SizeInBytes,
+			"visibility":   file.Visibility,
+			"deletedAt":    file.DeletedAt,
+			"purgeAt":      purgeAt,
+			"createdAt":    file.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// RestoreFile çöp kutusundaki bir dosyayı geri yükler
+func (h *Handler) RestoreFile(c *gin.Context) {
+	file := h.getTrashedFile(c)
+	if file == nil {
+		return
+	}
+
+	if err := h.FileRepository.RestoreFile(c.Request.Context(), file.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_restore_failed",
+			"message": "Dosya geri yüklenemedi: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Dosya geri yüklendi",
+		"data": gin.H{
+			"id":  file.ID.String(),
+			"url": h.fileAccessURL(file.ID, file.ObjectKey, file.Visibility),
+		},
+	})
+}
+
+// PermanentlyDeleteFile çöp kutusundaki bir dosyayı storage nesnesiyle birlikte kalıcı olarak siler.
+// Sadece adminler tarafından kullanılabilir.
+func (h *Handler) PermanentlyDeleteFile(c *gin.Context) {
+	file := h.getTrashedFile(c)
+	if file == nil {
+		return
+	}
+
+	if err := h.purgeFile(c.Request.Context(), file); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_purge_failed",
+			"message": "Dosya kalıcı olarak silinemedi: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Dosya kalıcı olarak silindi",
+	})
+}
+
+// PurgeExpiredTrash saklama süresi dolmuş çöp kutusu kayıtlarını storage nesneleriyle birlikte siler.
+// Taranamayan (scan_failed) dosyalar da incelenebilmeleri için aynı süre boyunca tutulur, ardından silinir.
+// AutomationService tarafından periyodik olarak çağrılır.
+func (h *Handler) PurgeExpiredTrash(ctx context.Context) {
+	expiredBefore := time.Now().Add(-configs.GetFileTrashRetention())
+	h.purgeExpiredScanFailedFiles(ctx, expiredBefore)
+
+	files, err := h.FileRepository.GetExpiredTrashedFiles(ctx, expiredBefore)
+	if err != nil {
+		log.Printf("[FILES] Süresi dolmuş çöp kutusu kayıtları alınamadı: %v", err)
+		return
+	}
+
+	purged := 0
+	for _, file := range files {
+		if err := h.purgeFile(ctx, &file); err != nil {
+			log.Printf("[FILES] Dosya kalıcı olarak silinemedi (id: %s): %v", file.ID, err)
+			continue
+		}
+		purged++
+	}
+
+	if purged > 0 {
+		log.Printf("[FILES] Çöp kutusundan %d dosya kalıcı olarak silindi.", purged)
+	}
+}
+
+// purgeExpiredScanFailedFiles verilen tarihten önce scan_failed durumuna alınmış dosyaları storage nesneleriyle birlikte siler
+func (h *Handler) purgeExpiredScanFailedFiles(ctx context.Context, failedBefore time.Time) {
+	files, err := h.FileRepository.GetExpiredScanFailedFiles(ctx, failedBefore)
+	if err != nil {
+		log.Printf("[FILES] Süresi dolmuş taranamayan dosyalar alınamadı: %v", err)
+		return
+	}
+
+	purged := 0
+	for _, file := range files {
+		if err := h.purgeFile(ctx, &file); err != nil {
+			log.Printf("[FILES] Taranamayan dosya kalıcı olarak silinemedi (id: %s): %v", file.ID, err)
+			continue
+		}
+		purged++
+	}
+
+	if purged > 0 {
+		log.Printf("[FILES] Taranamayan %d dosya kalıcı olarak silindi.", purged)
+	}
+}
+
+// TrashExpiredRetentionFiles saklama süresi (RetentionDays) tanımlı kategorilerde süresi dolan dosyaları çöp kutusuna taşır.
+// Dosyalar çöp kutusunun saklama süresi boyunca geri yüklenebilir, ardından PurgeExpiredTrash tarafından silinir.
+// AutomationService tarafından periyodik olarak çağrılır.
+func (h *Handler) TrashExpiredRetentionFiles(ctx context.Context) {
+	for _, policy := range configs.FileCategoryPolicies {
+		if policy.RetentionDays <= 0 {
+			continue
+		}
+
+		createdBefore := time.Now().AddDate(0, 0, -policy.RetentionDays)
+		trashed, err := h.FileRepository.TrashExpiredFiles(ctx, policy.Name, createdBefore)
+		if err != nil {
+			log.Printf("[FILES] Saklama süresi dolan dosyalar çöp kutusuna taşınamadı (%s): %v", policy.Name, err)
+			continue
+		}
+
+		if trashed > 0 {
+			log.Printf("[FILES] Saklama süresi dolan %d dosya çöp kutusuna taşındı (%s).", trashed, policy.Name)
+		}
+	}
+}
+
+// purgeFile önce storage nesnesini, ardından veritabanı kaydını siler.
+// Nesne silinemezse kayıt korunur ve bir sonraki denemede tekrar denenir.
+func (h *Handler) purgeFile(ctx context.Context, file *types.File) error {
+	objectKey := file.ObjectKey
+	if err := h.StorageService.DeleteObject(ctx, objectKey); err != nil {
+		return err
+	}
+	return h.FileRepository.PurgeFile(ctx, file.ID)
+}
+
+// getTrashedFile URL'deki ID'ye ait çöp kutusundaki dosyayı getirir.
+// Dosya bulunamazsa veya kullanıcının kategoriye yetkisi yoksa uygun yanıtı döner ve nil döndürür.
+func (h *Handler) getTrashedFile(c *gin.Context) *types.File {
+	fileID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_file_id",
+			"message": "Geçersiz dosya ID'si",
+		})
+		return nil
+	}
+
+	file, err := h.FileRepository.GetTrashedFileByID(c.Request.Context(), fileID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_fetch_failed",
+			"message": "Dosya bilgileri getirilemedi: " + err.Error(),
+		})
+		return nil
+	}
+
+	if file == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "file_not_found",
+			"message": "Dosya çöp kutusunda bulunamadı",
+		})
+		return nil
+	}
+
+	if !h.checkCategoryAccess(c, file.FileCategory) {
+		return nil
+	}
+
+	return file
+}
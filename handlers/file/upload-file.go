@@ -0,0 +1,258 @@
+// handlers/file/upload-file.go
+package FileHandler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+var errFileTooLarge = errors.New("dosya boyutu sınırı aşıldı")
+
+// UploadFile dosyayı multipart/form-data olarak alır, sunucu üzerinden storage'a aktarır ve kaydını oluşturur.
+// Presign/PUT/confirm adımlarını uygulayamayan istemciler (dahili araçlar, worker) içindir.
+// Kategori "fileCategory" form alanında dosyadan önce ya da aynı isimli query parametresinde gönderilmelidir.
+// Gövde belleğe alınmadan akış halinde okunur; içerik tipi dosyanın ilk byte'larından doğrulanır.
+func (h *Handler) UploadFile(c *gin.Context) {
+	// Form alanları ve sınırlar için küçük bir pay bırakılır
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, configs.FILE_PROXY_UPLOAD_MAX_SIZE+(1<<20))
+
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "invalid_multipart",
+			"message": "İstek multipart/form-data formatında olmalıdır",
+		})
+		return
+	}
+
+	fileCategory := c.Query("fileCategory")
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "invalid_multipart",
+				"message": "Form verisi okunamadı: " + err.Error(),
+			})
+			return
+		}
+
+		switch part.FormName() {
+		case "fileCategory":
+			value, _ := io.ReadAll(io.LimitReader(part, 256))
+			fileCategory = strings.TrimSpace(string(value))
+		case "file":
+			h.uploadFilePart(c, part, fileCategory)
+			part.Close()
+			return
+		}
+		part.Close()
+	}
+
+	c.JSON(http.StatusBadRequest, gin.H{
+		"success": false,
+		"error":   "missing_file",
+		"message": "Form verisinde 'file' alanı bulunamadı",
+	})
+}
+
+// uploadFilePart tek bir dosya parçasını doğrular, storage'a aktarır ve veritabanına kaydeder
+func (h *Handler) uploadFilePart(c *gin.Context, part *multipart.Part, fileCategory string) {
+	// Boyut henüz bilinmediğinden kota burada sadece dolu olup olmadığına bakılarak kontrol edilir
+	policy := h.getFileCategoryPolicy(c, fileCategory)
+	if policy == nil || !h.checkStorageQuota(c, policy.Name, 0) {
+		return
+	}
+
+	filename := path.Base(strings.ReplaceAll(part.FileName(), "\\", "/"))
+	if filename == "" || filename == "." || filename == "/" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "missing_filename",
+			"message": "Dosya adı gereklidir",
+		})
+		return
+	}
+
+	// Bildirilen tip yoksa veya genelse dosya uzantısından belirlenir
+	contentType := utils.MediaType(part.Header.Get("Content-Type"))
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType = utils.MediaType(mime.TypeByExtension(path.Ext(filename)))
+	}
+
+	// Boyut henüz bilinmediğinden burada sadece tip kontrol edilir; boyut akış sırasında sınırlanır
+	if !h.checkFileAllowed(c, policy, filename, contentType, 0) {
+		return
+	}
+
+	// İçeriğin ilk byte'larını okuyarak gerçek tipini doğrula
+	head := make([]byte, configs.FILE_SNIFF_LENGTH)
+	n, err := io.ReadFull(part, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "file_read_failed",
+			"message": "Dosya okunamadı: " + err.Error(),
+		})
+		return
+	}
+	head = head[:n]
+
+	if n == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "empty_file",
+			"message": "Dosya boş olamaz",
+		})
+		return
+	}
+
+	if sniffed := utils.SniffContentType(head); !utils.IsSniffedTypeCompatible(contentType, sniffed) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "content_type_mismatch",
+			"message": fmt.Sprintf("Dosya içeriği (%s) bildirilen tiple (%s) uyuşmuyor", sniffed, contentType),
+		})
+		return
+	}
+
+	maxSize := min(h.maxUploadSize(*policy), configs.FILE_PROXY_UPLOAD_MAX_SIZE)
+	hash := sha256.New()
+	body := io.TeeReader(&sizeLimitReader{
+		reader:    io.MultiReader(bytes.NewReader(head), part),
+		remaining: maxSize,
+	}, hash)
+
+	output, err := h.StorageService.UploadObject(c.Request.Context(), types.PresignURLInput{
+		Filename:     filename,
+		ContentType:  contentType,
+		FileCategory: policy.Name,
+		Visibility:   h.storageVisibility(policy.Visibility),
+	}, body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.Is(err, errFileTooLarge) || errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+				"success": false,
+				"error":   "file_too_large",
+				"message": fmt.Sprintf("Dosya boyutu sunucu üzerinden yükleme için izin verilen %d byte sınırını aşıyor", maxSize),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "upload_failed",
+			"message": "Dosya yüklenemedi: " + err.Error(),
+		})
+		return
+	}
+
+	// Aynı içerik bu kategoride zaten varsa yeni nesneyi sil ve mevcut dosyayı döndür
+	contentHash := hex.EncodeToString(hash.Sum(nil))
+	existing, err := h.FileRepository.GetFileByContentHash(c.Request.Context(), contentHash, policy.Name)
+	if err != nil {
+		log.Printf("[FILES] Yüklenen dosya için mevcut kayıtlar kontrol edilemedi: %v", err)
+	}
+
+	if existing != nil {
+		if err := h.StorageService.DeleteObject(c.Request.Context(), output.ObjectKey); err != nil {
+			log.Printf("[FILES] Tekrar eden yükleme silinemedi (key: %s): %v", output.ObjectKey, err)
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"id":         existing.ID.String(),
+				"url":        h.fileAccessURL(existing.ID, existing.ObjectKey, existing.Visibility),
+				"visibility": existing.Visibility,
+				"duplicate":  true,
+			},
+		})
+		return
+	}
+
+	// Gerçek boyut kotaya sığmıyorsa yüklenen nesne silinir
+	if !h.checkStorageQuota(c, policy.Name, output.SizeInBytes) {
+		h.StorageService.DeleteObject(c.Request.Context(), output.ObjectKey)
+		return
+	}
+
+	status := h.initialFileStatus()
+	userID, _ := currentUser(c)
+	fileID, err := h.FileRepository.CreateFileRecord(c.Request.Context(), types.SaveFileInput{
+		URL:          h.publicFileURL(output.ObjectKey),
+		ObjectKey:    output.ObjectKey,
+		Filename:     filename,
+		FileType:     contentType,
+		FileCategory: policy.Name,
+		SizeInBytes:  output.SizeInBytes,
+		Visibility:   policy.Visibility,
+		ContentHash:  &contentHash,
+		Status:       status,
+		UploadedBy:   &userID,
+	})
+	if err != nil {
+		h.StorageService.DeleteObject(c.Request.Context(), output.ObjectKey)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "file_save_failed",
+			"message": "Dosya kaydedilemedi: " + err.Error(),
+		})
+		return
+	}
+
+	h.scheduleScan(types.File{
+		ID:           fileID,
+		ObjectKey:    output.ObjectKey,
+		Filename:     filename,
+		FileCategory: policy.Name,
+		SizeInBytes:  output.SizeInBytes,
+		Visibility:   policy.Visibility,
+		Status:       string(status),
+	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"id":          fileID.String(),
+			"status":      status,
+			"url":         h.uploadedFileURL(fileID, output.ObjectKey, policy.Visibility, status),
+			"visibility":  policy.Visibility,
+			"sizeInBytes": output.SizeInBytes,
+		},
+	})
+}
+
+// sizeLimitReader, sınır aşıldığında errFileTooLarge döndürür.
+// Böylece storage yüklemesi yarıda kesilir ve kısmi nesne oluşmaz.
+type sizeLimitReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, errFileTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,109 @@
+package GithubHandler
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	GithubService "github.com/okanay/backend-template/services/github"
+	"github.com/okanay/backend-template/types"
+)
+
+// category, etkin bir kategoriyi adıyla döndürür
+func (h *Handler) category(contentType ContentType) (ContentCategory, bool) {
+	h.categoriesMu.RLock()
+	defer h.categoriesMu.RUnlock()
+
+	category, exists := h.categories[contentType]
+	return category, exists
+}
+
+// categoryList, etkin kategorileri ada göre sıralı döndürür
+func (h *Handler) categoryList() []ContentCategory {
+	h.categoriesMu.RLock()
+	categories := make([]ContentCategory, 0, len(h.categories))
+	for _, category := range h.categories {
+		categories = append(categories, category)
+	}
+	h.categoriesMu.RUnlock()
+
+	slices.SortFunc(categories, func(a, b ContentCategory) int {
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+	return categories
+}
+
+// repo, kategorinin içerik reposu için GitHub servisini döndürür
+func (h *Handler) repo(category ContentCategory) *GithubService.Service {
+	return h.repository.WithRepository(category.RepoOwner, category.RepoName)
+}
+
+// ReloadCategories, etkin kategorileri veritabanından yeniden yükler. Sunucu yeniden başlatılmadan
+// yapılan değişiklikler bu yolla uygulanır; hata olursa mevcut kategoriler korunur.
+func (h *Handler) ReloadCategories(ctx context.Context) error {
+	records, err := h.contentRepository.ListContentCategories(ctx)
+	if err != nil {
+		return err
+	}
+
+	categories := make(map[ContentType]ContentCategory, len(records))
+	for _, record := range records {
+		if record.Enabled {
+			categories[ContentType(record.Type)] = h.toContentCategory(record)
+		}
+	}
+
+	h.categoriesMu.Lock()
+	previous := h.categories
+	h.categories = categories
+	h.categoriesMu.Unlock()
+
+	// Reposu veya şema klasörü değişen kategorilerin şemaları yeniden okunmalıdır
+	for contentType, category := range previous {
+		current, exists := categories[contentType]
+		if !exists || current.RepoOwner != category.RepoOwner || current.RepoName != category.RepoName ||
+			current.BaseBranch != category.BaseBranch || current.SchemaPath != category.SchemaPath {
+			h.schemas.forget(contentType)
+		}
+	}
+	return nil
+}
+
+// reloadCategoriesLogged, kategori değişikliklerinden sonra kategorileri yeniden yükler ve hatayı loglar
+func (h *Handler) reloadCategoriesLogged() {
+	if err := h.ReloadCategories(context.Background()); err != nil {
+		log.Printf("[GITHUB] İçerik kategorileri yeniden yüklenemedi: %v", err)
+	}
+}
+
+// toContentCategory, veritabanı kaydını handler'ın kullandığı kategoriye çevirir; boş repo bilgileri varsayılan repoyla doldurulur
+func (h *Handler) toContentCategory(record types.ContentCategory) ContentCategory {
+	category := ContentCategory{
+		Type:        ContentType(record.Type),
+		Name:        record.Name,
+		Path:        strings.Trim(record.Path, "/"),
+		Description: record.Description,
+		Extensions:  record.Extensions,
+		DraftBranch: record.DraftBranch,
+		MaxSize:     record.MaxSize,
+		RepoOwner:   h.repository.RepoOwner,
+		RepoName:    h.repository.RepoName,
+		BaseBranch:  record.BaseBranch,
+		Validator:   record.Validator,
+		UserDrafts:  record.UserDrafts,
+	}
+	if record.RepoOwner != nil && *record.RepoOwner != "" {
+		category.RepoOwner = *record.RepoOwner
+	}
+	if record.RepoName != nil && *record.RepoName != "" {
+		category.RepoName = *record.RepoName
+	}
+	if record.SchemaPath != nil {
+		category.SchemaPath = strings.Trim(*record.SchemaPath, "/")
+	}
+	if category.Extensions == nil {
+		category.Extensions = []string{}
+	}
+	return category
+}
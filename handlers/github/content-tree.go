@@ -0,0 +1,187 @@
+package GithubHandler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bundleWorkers, bundle oluşturulurken aynı anda indirilen dosya sayısı
+const bundleWorkers = 8
+
+type ContentFile struct {
+	Path    string  `json:"path"`
+	SHA     string  `json:"sha"`
+	Size    int     `json:"size"`
+	Content *string `json:"content,omitempty"`
+}
+
+// GetTree, kategorinin etkin branch'teki (taslak varsa taslak, yoksa main) tüm dosyalarını listeler.
+// Yanıt branch'in son commit SHA'sı ile ETag olarak işaretlenir; değişiklik yoksa 304 döner.
+func (h *Handler) GetTree(c *gin.Context) {
+	category, ok := h.requestCategory(c, ContentType(c.Param("category")))
+	if !ok {
+		return
+	}
+
+	branch, headSHA, err := h.resolveEffectiveBranch(category)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not resolve branch",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if notModified(c, headSHA) {
+		return
+	}
+
+	files, err := h.listCategoryFiles(category, headSHA)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not list files",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"category": category.Type,
+		"branch":   branch,
+		"sha":      headSHA,
+		"files":    files,
+	})
+}
+
+// GetBundle, kategorinin etkin branch'teki tüm dosyalarını içerikleriyle birlikte tek yanıtta döndürür.
+// İstemci If-None-Match ile son aldığı ETag'i gönderirse ve branch değişmediyse 304 döner.
+func (h *Handler) GetBundle(c *gin.Context) {
+	category, ok := h.requestCategory(c, ContentType(c.Param("category")))
+	if !ok {
+		return
+	}
+
+	branch, headSHA, err := h.resolveEffectiveBranch(category)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not resolve branch",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if notModified(c, headSHA) {
+		return
+	}
+
+	files, err := h.listCategoryFiles(category, headSHA)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not list files",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Dosyalar blob SHA'ları üzerinden paralel olarak indirilir
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var failed []string
+	semaphore := make(chan struct{}, bundleWorkers)
+	for i := range files {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(file *ContentFile) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			content, err := h.repo(category).GetBlob(file.SHA)
+			if err != nil {
+				mu.Lock()
+				failed = append(failed, file.Path)
+				mu.Unlock()
+				return
+			}
+			text := string(content)
+			file.Content = &text
+		}(&files[i])
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		c.Writer.Header().Del("ETag")
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error":   "Could not fetch file contents",
+			"details": fmt.Sprintf("%d files could not be fetched", len(failed)),
+			"paths":   failed,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"category": category.Type,
+		"branch":   branch,
+		"sha":      headSHA,
+		"files":    files,
+	})
+}
+
+// resolveEffectiveBranch, okunacak branch'i (taslak varsa taslak, yoksa main) ve son commit SHA'sını döndürür
+func (h *Handler) resolveEffectiveBranch(category ContentCategory) (string, string, error) {
+	headSHA, err := h.repo(category).GetBranchHead(category.DraftBranch)
+	if err != nil {
+		return "", "", err
+	}
+	if headSHA != "" {
+		return category.DraftBranch, headSHA, nil
+	}
+
+	headSHA, err = h.repo(category).GetBranchHead(category.BaseBranch)
+	if err != nil {
+		return "", "", err
+	}
+	if headSHA == "" {
+		return "", "", fmt.Errorf("branch %s not found", category.BaseBranch)
+	}
+	return category.BaseBranch, headSHA, nil
+}
+
+// listCategoryFiles, verilen commit'te kategori klasörü altındaki izin verilen uzantılı dosyaları listeler
+func (h *Handler) listCategoryFiles(category ContentCategory, ref string) ([]ContentFile, error) {
+	treeFiles, err := h.repo(category).ListFiles(ref, category.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]ContentFile, 0, len(treeFiles))
+	for _, file := range treeFiles {
+		if !h.isAllowedExtension(file.Path, category.Extensions) {
+			continue
+		}
+		files = append(files, ContentFile{Path: file.Path, SHA: file.SHA, Size: file.Size})
+	}
+	return files, nil
+}
+
+// notModified, yanıtı branch'in son commit SHA'sından türetilen ETag ile işaretler.
+// İstemcinin gönderdiği If-None-Match eşleşirse 304 yazar ve true döndürür.
+func notModified(c *gin.Context, headSHA string) bool {
+	etag := `"` + headSHA + `"`
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, no-cache")
+
+	for _, candidate := range strings.Split(c.GetHeader("If-None-Match"), ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+package GithubHandler
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// schemaFileSuffix, şema dosyalarının uzantısı
+const schemaFileSuffix = ".schema.json"
+
+// ContentIssue, bir içerik dosyasındaki tek bir doğrulama hatasıdır. Satır ve sütun biliniyorsa doludur.
+type ContentIssue struct {
+	Path    string `json:"path"`
+	Pointer string `json:"pointer,omitempty"` // JSON Pointer (ör. "/nav/0/title")
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// ContentValidationError, içeriğin şema veya dil dosyası kurallarına uymadığını bildirir
+type ContentValidationError struct {
+	Issues []ContentIssue
+}
+
+func (e *ContentValidationError) Error() string {
+	if len(e.Issues) == 0 {
+		return "content validation failed"
+	}
+
+	first := e.Issues[0]
+	message := first.Path
+	if first.Line > 0 {
+		message += fmt.Sprintf(":%d:%d", first.Line, first.Column)
+	}
+	message += ": " + first.Message
+	if len(e.Issues) > 1 {
+		message += fmt.Sprintf(" (and %d more issues)", len(e.Issues)-1)
+	}
+	return message
+}
+
+// contentSchemas, kategorilerin şemalarını temel branch'in son commit'ine göre önbellekte tutar.
+// Şemalar temel branch'ten (ör. main) okunur; böylece yalnızca yayınlanmış şemalar geçerli olur ve taslakta gevşetilemez.
+type contentSchemas struct {
+	mu      sync.Mutex
+	headSHA map[ContentType]string
+	schemas map[ContentType]map[string]*utils.JSONSchema // Kategori klasörüne göre göreli şema yolu -> şema
+}
+
+func newContentSchemas() *contentSchemas {
+	return &contentSchemas{
+		headSHA: make(map[ContentType]string),
+		schemas: make(map[ContentType]map[string]*utils.JSONSchema),
+	}
+}
+
+// forget, kategorinin önbellekteki şemalarını siler; şemalar bir sonraki doğrulamada yeniden yüklenir
+func (s *contentSchemas) forget(contentType ContentType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.schemas, contentType)
+	delete(s.headSHA, contentType)
+}
+
+// validateJSONContent, JSON içeriğini sözdizimi ve varsa kategorinin şemasına göre doğrular
+func (h *Handler) validateJSONContent(category ContentCategory, filePath, content string) error {
+	schema, err := h.schemaFor(category, filePath)
+	if err != nil {
+		return fmt.Errorf("could not load content schema: %w", err)
+	}
+
+	var schemaErrors []utils.JSONSchemaError
+	if schema != nil {
+		schemaErrors = schema.Validate([]byte(content))
+	} else if _, _, syntaxErr := utils.ParseJSONWithPositions([]byte(content)); syntaxErr != nil {
+		schemaErrors = []utils.JSONSchemaError{*syntaxErr}
+	}
+
+	if len(schemaErrors) == 0 {
+		return nil
+	}
+
+	issues := make([]ContentIssue, 0, len(schemaErrors))
+	for _, schemaErr := range schemaErrors {
+		issues = append(issues, ContentIssue{
+			Path:    filePath,
+			Pointer: schemaErr.Pointer,
+			Line:    schemaErr.Line,
+			Column:  schemaErr.Column,
+			Message: schemaErr.Message,
+		})
+	}
+	return &ContentValidationError{Issues: issues}
+}
+
+// schemaFor, dosyaya uygulanacak şemayı döndürür. Şema yoksa nil döner.
+// Örneğin "src/messages/en/common.json" için sırasıyla "en/common.schema.json", "common.schema.json"
+// ve "default.schema.json" aranır.
+func (h *Handler) schemaFor(category ContentCategory, filePath string) (*utils.JSONSchema, error) {
+	schemas, err := h.loadSchemas(category)
+	if err != nil || len(schemas) == 0 {
+		return nil, err
+	}
+
+	relative := strings.TrimPrefix(strings.TrimPrefix(filePath, category.Path), "/")
+	relative = strings.TrimSuffix(relative, path.Ext(relative))
+
+	for _, candidate := range []string{relative, path.Base(relative), "default"} {
+		if schema, ok := schemas[candidate+schemaFileSuffix]; ok {
+			return schema, nil
+		}
+	}
+	return nil, nil
+}
+
+// loadSchemas, kategorinin şemalarını içerik reposundan ve sunucudaki şema klasöründen yükler.
+// Repo şemaları temel branch değiştiğinde yeniden okunur; aynı yoldaki şemalarda repo önceliklidir.
+func (h *Handler) loadSchemas(category ContentCategory) (map[string]*utils.JSONSchema, error) {
+	headSHA := ""
+	if category.SchemaPath != "" {
+		var err error
+		headSHA, err = h.repo(category).GetBranchHead(category.BaseBranch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	h.schemas.mu.Lock()
+	defer h.schemas.mu.Unlock()
+
+	if schemas, ok := h.schemas.schemas[category.Type]; ok && h.schemas.headSHA[category.Type] == headSHA {
+		return schemas, nil
+	}
+
+	schemas, err := loadLocalSchemas(category.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if headSHA != "" {
+		files, err := h.repo(category).ListFiles(headSHA, category.SchemaPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if !strings.HasSuffix(file.Path, schemaFileSuffix) {
+				continue
+			}
+			content, err := h.repo(category).GetBlob(file.SHA)
+			if err != nil {
+				return nil, err
+			}
+			schema, err := utils.ParseJSONSchema(content)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file.Path, err)
+			}
+			schemas[strings.TrimPrefix(file.Path, strings.TrimSuffix(category.SchemaPath, "/")+"/")] = schema
+		}
+	}
+
+	h.schemas.schemas[category.Type] = schemas
+	h.schemas.headSHA[category.Type] = headSHA
+	return schemas, nil
+}
+
+// loadLocalSchemas, GITHUB_CONTENT_SCHEMA_DIR/<kategori> altındaki şemaları okur
+func loadLocalSchemas(contentType ContentType) (map[string]*utils.JSONSchema, error) {
+	schemas := make(map[string]*utils.JSONSchema)
+
+	dir := configs.GetGithubContentSchemaDir()
+	if dir == "" {
+		return schemas, nil
+	}
+
+	root := filepath.Join(dir, string(contentType))
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(filePath, schemaFileSuffix) {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		schema, err := utils.ParseJSONSchema(content)
+		if err != nil {
+			return fmt.Errorf("%s: %w", filePath, err)
+		}
+
+		relative, _ := filepath.Rel(root, filePath)
+		schemas[filepath.ToSlash(relative)] = schema
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return schemas, nil
+}
+
+// checkDraftContent, taslakta değişen dosyaları ve dil dosyası uyumunu yayınlamadan önce doğrular.
+// İçerik kurallara uymuyorsa *ContentValidationError döner.
+func (h *Handler) checkDraftContent(category ContentCategory) error {
+	repository := h.repo(category)
+	headSHA, err := repository.GetBranchHead(category.DraftBranch)
+	if err != nil {
+		return err
+	}
+	if headSHA == "" {
+		return nil
+	}
+
+	changes, err := repository.GetBranchChanges(category.BaseBranch, category.DraftBranch)
+	if err != nil {
+		return err
+	}
+
+	files, err := h.fileIndexAt(category, headSHA, category.Path)
+	if err != nil {
+		return err
+	}
+
+	var issues []ContentIssue
+	for _, change := range filterCategoryChanges(category, changes) {
+		sha, exists := files[change.Path]
+		if !exists || !h.isAllowedExtension(change.Path, category.Extensions) {
+			continue
+		}
+
+		content, err := repository.GetBlob(sha)
+		if err != nil {
+			return err
+		}
+
+		var validationErr *ContentValidationError
+		if err := h.validateContent(string(content), category, change.Path); errors.As(err, &validationErr) {
+			issues = append(issues, validationErr.Issues...)
+		} else if err != nil {
+			issues = append(issues, ContentIssue{Path: change.Path, Message: err.Error()})
+		}
+	}
+
+	if category.Validator == types.ContentValidatorI18n {
+		parityIssues, err := h.checkLocaleParity(category, headSHA, nil)
+		if err != nil {
+			return err
+		}
+		issues = append(issues, parityIssues...)
+	}
+
+	if len(issues) > 0 {
+		return &ContentValidationError{Issues: issues}
+	}
+	return nil
+}
+
+// checkDraftContentResponse taslak içeriği doğrular. İçerik geçersizse veya doğrulanamazsa uygun yanıtı yazar ve false döndürür.
+func (h *Handler) checkDraftContentResponse(c *gin.Context, category ContentCategory) bool {
+	err := h.checkDraftContent(category)
+	if err == nil {
+		return true
+	}
+
+	var validationErr *ContentValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "Draft content validation failed",
+			"details": validationErr.Error(),
+			"issues":  validationErr.Issues,
+		})
+		return false
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error":   "Could not validate draft content",
+		"details": err.Error(),
+	})
+	return false
+}
@@ -0,0 +1,171 @@
+package GithubHandler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	GithubService "github.com/okanay/backend-template/services/github"
+)
+
+// DeleteContent, verilen dosyaları taslak branch'ten tek bir commit ile siler.
+// Silme işlemi yayınlanana kadar main branch etkilenmez; draft-status'ta "deleted" olarak görünür.
+func (h *Handler) DeleteContent(c *gin.Context) {
+	var req struct {
+		Paths   []string `json:"paths" binding:"required,min=1,dive,required"`
+		Message string   `json:"message"`
+		HeadSHA string   `json:"headSha"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	contentType := ContentType(c.Param("category"))
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
+		return
+	}
+
+	if len(req.Paths) > maxBatchFiles {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("A batch can contain at most %d files", maxBatchFiles),
+		})
+		return
+	}
+
+	if err := h.ensureDraftBranch(category); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not create draft branch",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	draftBranch := category.DraftBranch
+	existing, err := h.categoryFileIndex(category, draftBranch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not list files",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Güvenlik kontrolleri ve dosyaların taslak branch'te var olduğunun doğrulanması
+	var fileErrors []BatchFileError
+	seen := make(map[string]bool, len(req.Paths))
+	changes := make([]GithubService.FileChange, 0, len(req.Paths))
+	for _, path := range req.Paths {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		if err := h.checkContentPath(category, path); err != nil {
+			fileErrors = append(fileErrors, BatchFileError{Path: path, Error: err.Error()})
+			continue
+		}
+		if _, ok := existing[path]; !ok {
+			fileErrors = append(fileErrors, BatchFileError{Path: path, Error: "file not found"})
+			continue
+		}
+		changes = append(changes, GithubService.FileChange{Path: path, Delete: true})
+	}
+
+	if len(fileErrors) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "Could not delete files",
+			"errors": fileErrors,
+		})
+		return
+	}
+
+	commitMessage := req.Message
+	if commitMessage == "" {
+		if len(changes) == 1 {
+			commitMessage = fmt.Sprintf("feat(%s): delete %s", contentType, filepath.Base(changes[0].Path))
+		} else {
+			commitMessage = fmt.Sprintf("feat(%s): delete %d files", contentType, len(changes))
+		}
+	}
+
+	commitSHA, ok := h.commitChanges(c, category, draftBranch, req.HeadSHA, changes, commitMessage)
+	if !ok {
+		return
+	}
+
+	// Silinen dosyaların referansları indeksten kaldırılır
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		h.updateFileReferences(contentType, draftBranch, change.Path, "")
+		paths = append(paths, change.Path)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":   fmt.Sprintf("%d files deleted from %s", len(changes), draftBranch),
+		"sha":      commitSHA,
+		"branch":   draftBranch,
+		"category": contentType,
+		"paths":    paths,
+		"success":  true,
+	})
+}
+
+// commitChanges, değişiklikleri taslak branch'e commit'ler ve hata durumunda uygun yanıtı yazar.
+// Branch beklenen SHA'dan sonra güncellenmişse 409 döner.
+func (h *Handler) commitChanges(c *gin.Context, category ContentCategory, branch, headSHA string, changes []GithubService.FileChange, message string) (string, bool) {
+	commitSHA, err := h.repo(category).CommitFiles(branch, headSHA, changes, message)
+	if err == nil {
+		return commitSHA, true
+	}
+
+	if errors.Is(err, GithubService.ErrBranchHeadMismatch) {
+		currentSHA, _ := h.repo(category).GetBranchHead(branch)
+		c.JSON(http.StatusConflict, gin.H{
+			"error":      "Draft branch has been updated by someone else, reload the content and try again",
+			"currentSha": currentSHA,
+		})
+		return "", false
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error":   "Could not commit content",
+		"details": err.Error(),
+	})
+	return "", false
+}
+
+// categoryFileIndex, branch'teki kategori dosyalarını yola göre blob SHA'larıyla döndürür
+func (h *Handler) categoryFileIndex(category ContentCategory, branch string) (map[string]string, error) {
+	files, err := h.listCategoryFiles(category, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]string, len(files))
+	for _, file := range files {
+		index[file.Path] = file.SHA
+	}
+	return index, nil
+}
+
+// checkContentPath, yolun kategori klasörü altında ve izin verilen uzantıda olduğunu doğrular
+func (h *Handler) checkContentPath(category ContentCategory, path string) error {
+	if !strings.HasPrefix(path, category.Path) || strings.Contains(path, "..") {
+		return fmt.Errorf("access denied to this path")
+	}
+
+	if !h.isAllowedExtension(path, category.Extensions) {
+		return fmt.Errorf("file extension not allowed for this category")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package GithubHandler
+
+import (
+	"context"
+	"log"
+
+	"github.com/okanay/backend-template/utils"
+)
+
+// updateFileReferences kaydedilen içerikte geçen dosya URL'lerini referans indeksine yazar.
+// İndeks periyodik olarak yeniden oluşturulduğu için hata durumunda kaydetme işlemi başarısız sayılmaz.
+func (h *Handler) updateFileReferences(contentType ContentType, branch, path, content string) {
+	err := h.fileRepository.ReplaceContentFileReferences(context.Background(), string(contentType), branch, path, utils.ExtractURLs(content))
+	if err != nil {
+		log.Printf("[GITHUB] Dosya referansları güncellenemedi (%s:%s): %v", branch, path, err)
+	}
+}
+
+// RebuildFileReferences tüm kategorilerin temel ve taslak branch'lerini (kişisel taslaklar dahil) tarayarak
+// içerik referans indeksini yeniden oluşturur. Artık var olmayan taslakların referansları silinir.
+// AutomationService tarafından periyodik olarak çağrılır.
+func (h *Handler) RebuildFileReferences(ctx context.Context) {
+	for _, category := range h.categoryList() {
+		contentType := category.Type
+		drafts, err := h.draftBranches(category)
+		if err != nil {
+			log.Printf("[GITHUB] Taslak branch'leri listelenemedi (%s): %v", contentType, err)
+			continue
+		}
+
+		branches := append([]string{category.BaseBranch}, drafts...)
+		for _, branch := range branches {
+			references, err := h.scanFileReferences(category, branch)
+			if err != nil {
+				log.Printf("[GITHUB] Referans taraması başarısız (%s:%s): %v", contentType, branch, err)
+				continue
+			}
+
+			if err := h.fileRepository.ReplaceCategoryFileReferences(ctx, string(contentType), branch, references); err != nil {
+				log.Printf("[GITHUB] Referans indeksi yazılamadı (%s:%s): %v", contentType, branch, err)
+			}
+		}
+
+		if err := h.fileRepository.PruneCategoryFileReferences(ctx, string(contentType), branches); err != nil {
+			log.Printf("[GITHUB] Silinen taslakların referansları temizlenemedi (%s): %v", contentType, err)
+		}
+	}
+}
+
+// scanFileReferences bir branch'teki kategori dosyalarını okuyup her dosyada geçen URL'leri döndürür.
+// Branch yoksa boş sonuç döner, böylece silinmiş draft branch'lerin referansları temizlenir.
+func (h *Handler) scanFileReferences(category ContentCategory, branch string) (map[string][]string, error) {
+	references := make(map[string][]string)
+
+	exists, err := h.repo(category).BranchExists(branch)
+	if err != nil || !exists {
+		return references, err
+	}
+
+	files, err := h.repo(category).ListFiles(branch, category.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if !h.isAllowedExtension(file.Path, category.Extensions) {
+			continue
+		}
+
+		content, _, err := h.repo(category).GetFileContent(branch, file.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if urls := utils.ExtractURLs(string(content)); len(urls) > 0 {
+			references[file.Path] = urls
+		}
+	}
+
+	return references, nil
+}
@@ -7,10 +7,7 @@ import (
 )
 
 func (h *Handler) GetCategories(c *gin.Context) {
-	categories := make([]ContentCategory, 0, len(h.categories))
-	for _, category := range h.categories {
-		categories = append(categories, category)
-	}
+	categories := h.categoryList()
 
 	c.JSON(http.StatusOK, gin.H{
 		"categories": categories,
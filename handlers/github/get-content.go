@@ -17,9 +17,8 @@ func (h *Handler) GetContent(c *gin.Context) {
 	}
 
 	contentType := ContentType(categoryParam)
-	category, exists := h.categories[contentType]
-	if !exists {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
 		return
 	}
 
@@ -35,8 +34,8 @@ func (h *Handler) GetContent(c *gin.Context) {
 	}
 
 	// Hangi branch'dan okuyacağımızı belirle
-	branchToRead := h.mainBranch
-	draftExists, err := h.repository.BranchExists(category.DraftBranch)
+	branchToRead := category.BaseBranch
+	draftExists, err := h.repo(category).BranchExists(category.DraftBranch)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Could not check for draft branch",
@@ -48,7 +47,7 @@ func (h *Handler) GetContent(c *gin.Context) {
 		branchToRead = category.DraftBranch
 	}
 
-	content, sha, err := h.repository.GetFileContent(branchToRead, filePath)
+	content, sha, err := h.repo(category).GetFileContent(branchToRead, filePath)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error":  "Content not found",
@@ -0,0 +1,129 @@
+package GithubHandler
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	GithubService "github.com/okanay/backend-template/services/github"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+type ContentDiff struct {
+	Path           string          `json:"path"`
+	PreviousPath   string          `json:"previousPath,omitempty"`
+	Status         string          `json:"status"`
+	Patch          string          `json:"patch"`
+	PatchAvailable bool            `json:"patchAvailable"` // GitHub büyük dosyalarda patch döndürmez
+	Semantic       *utils.JSONDiff `json:"semantic,omitempty"`
+	SemanticError  string          `json:"semanticError,omitempty"`
+}
+
+// GetDiff, kategorinin taslak branch'inde main'e göre değişen dosyaların unified diff'lerini döndürür.
+// JSON dosyaları için ayrıca anahtar bazlı (eklenen, silinen, değişen anahtarlar) anlamsal fark hesaplanır.
+func (h *Handler) GetDiff(c *gin.Context) {
+	contentType := ContentType(c.Param("category"))
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
+		return
+	}
+
+	draftBranch := category.DraftBranch
+	exists, err := h.repo(category).BranchExists(draftBranch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not check for draft branch",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusOK, gin.H{
+			"success":    true,
+			"category":   contentType,
+			"hasChanges": false,
+			"files":      []ContentDiff{},
+		})
+		return
+	}
+
+	mergeBase, changes, err := h.repo(category).GetBranchDiff(category.BaseBranch, draftBranch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not get changes",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	files := []ContentDiff{}
+	for _, change := range changes {
+		if !strings.HasPrefix(change.Path, category.Path) && !strings.HasPrefix(change.PreviousPath, category.Path) {
+			continue
+		}
+
+		diff := ContentDiff{
+			Path:           change.Path,
+			PreviousPath:   change.PreviousPath,
+			Status:         change.Status,
+			Patch:          change.Patch,
+			PatchAvailable: change.Patch != "",
+		}
+
+		if (category.Validator == types.ContentValidatorJSON || category.Validator == types.ContentValidatorI18n) && isJSONPath(change) {
+			semantic, err := h.semanticJSONDiff(category, mergeBase, change)
+			if err != nil {
+				diff.SemanticError = err.Error()
+			} else {
+				diff.Semantic = semantic
+			}
+		}
+
+		files = append(files, diff)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"category":   contentType,
+		"base":       category.BaseBranch,
+		"branch":     draftBranch,
+		"mergeBase":  mergeBase,
+		"hasChanges": len(files) > 0,
+		"files":      files,
+	})
+}
+
+// semanticJSONDiff, dosyanın ortak atadaki ve taslak branch'teki hallerini anahtar bazında karşılaştırır
+func (h *Handler) semanticJSONDiff(category ContentCategory, mergeBase string, change GithubService.Change) (*utils.JSONDiff, error) {
+	var oldContent, newContent []byte
+	var err error
+
+	if change.Status != "added" {
+		oldPath := change.Path
+		if change.PreviousPath != "" {
+			oldPath = change.PreviousPath
+		}
+		oldContent, _, err = h.repo(category).GetFileContent(mergeBase, oldPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if change.Status != "deleted" {
+		newContent, err = h.repo(category).GetBlob(change.SHA)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return utils.DiffJSON(oldContent, newContent)
+}
+
+func isJSONPath(change GithubService.Change) bool {
+	isJSON := func(path string) bool {
+		return strings.EqualFold(filepath.Ext(path), ".json")
+	}
+	return isJSON(change.Path) && (change.PreviousPath == "" || isJSON(change.PreviousPath))
+}
@@ -10,12 +10,11 @@ func (h *Handler) GetDraftStatus(c *gin.Context) {
 	categoryParam := c.Param("category")
 	contentType := ContentType(categoryParam)
 
-	_, exists := h.categories[contentType]
-	if !exists {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
 		return
 	}
 
-	status := h.getCategoryDraftStatus(contentType)
+	status := h.getCategoryDraftStatus(category)
 	c.JSON(http.StatusOK, status)
 }
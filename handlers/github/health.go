@@ -0,0 +1,43 @@
+package GithubHandler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	GithubService "github.com/okanay/backend-template/services/github"
+)
+
+// GetHealth, GitHub API kotasının durumunu döndürür. Henüz istek yapılmadıysa veya "refresh=true" verilirse
+// kota GitHub'dan okunur; bu istek kotadan düşmez. Kota yedek sınırın altındayken istekler sıraya alındığından
+// "throttled", tamamen dolduğunda "exhausted" durumu döner.
+func (h *Handler) GetHealth(c *gin.Context) {
+	rateLimit := h.repository.RateLimit()
+	if rateLimit.UpdatedAt == nil || c.Query("refresh") == "true" {
+		refreshed, err := h.repository.RefreshRateLimit()
+		if err != nil && !errors.Is(err, GithubService.ErrRateLimited) {
+			c.JSON(http.StatusBadGateway, gin.H{
+				"error":     "Could not reach GitHub",
+				"details":   err.Error(),
+				"rateLimit": refreshed,
+			})
+			return
+		}
+		rateLimit = refreshed
+	}
+
+	status := "ok"
+	if rateLimit.Throttled {
+		status = "throttled"
+	}
+	if rateLimit.Remaining <= 0 && rateLimit.Reset.After(time.Now()) {
+		status = "exhausted"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"status":    status,
+		"rateLimit": rateLimit,
+	})
+}
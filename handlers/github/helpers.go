@@ -1,10 +1,20 @@
 package GithubHandler
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
+	"log"
 	"path/filepath"
+	"slices"
 	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	GithubService "github.com/okanay/backend-template/services/github"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
 )
 
 func (h *Handler) isAllowedExtension(filePath string, allowedExts []string) bool {
@@ -17,37 +27,38 @@ func (h *Handler) isAllowedExtension(filePath string, allowedExts []string) bool
 	return false
 }
 
-func (h *Handler) validateContent(content string, contentType ContentType, filePath string) error {
-	switch contentType {
-	case ContentTypeI18n, ContentTypeConfig:
-		// JSON validation
-		var jsonData interface{}
-		if err := json.Unmarshal([]byte(content), &jsonData); err != nil {
-			return fmt.Errorf("invalid JSON syntax: %s", err.Error())
+// validateContent, dosyayı kategorinin doğrulayıcısına göre kontrol eder
+func (h *Handler) validateContent(content string, category ContentCategory, filePath string) error {
+	switch category.Validator {
+	case types.ContentValidatorJSON, types.ContentValidatorI18n:
+		// JSON dosyaları sözdizimi ve kategorinin şemasına göre doğrulanır; .js/.ts yapılandırmaları JSON değildir
+		if strings.ToLower(filepath.Ext(filePath)) == ".json" {
+			return h.validateJSONContent(category, filePath, content)
 		}
 
-	case ContentTypeTheme:
-		// CSS validation (basic)
-		if strings.Contains(content, "javascript:") || strings.Contains(content, "@import") {
-			return fmt.Errorf("potentially dangerous CSS content detected")
+	case types.ContentValidatorCSS:
+		// Stil dosyaları belirteçlerine ayrılarak sözdizimi, at-rule ve url() kurallarına göre doğrulanır
+		scss := strings.ToLower(filepath.Ext(filePath)) == ".scss"
+		cssIssues := utils.ValidateCSS(content, scss, configs.GetThemeCSSPolicy(scss))
+		if len(cssIssues) == 0 {
+			return nil
 		}
 
-		// Basic bracket matching
-		openBraces := strings.Count(content, "{")
-		closeBraces := strings.Count(content, "}")
-		if openBraces != closeBraces {
-			return fmt.Errorf("mismatched braces in CSS")
+		issues := make([]ContentIssue, 0, len(cssIssues))
+		for _, issue := range cssIssues {
+			issues = append(issues, ContentIssue{Path: filePath, Line: issue.Line, Column: issue.Column, Message: issue.Message})
 		}
+		return &ContentValidationError{Issues: issues}
 	}
 
 	return nil
 }
 
-func (h *Handler) getCategoryDraftStatus(contentType ContentType) DraftStatusResponse {
-	category := h.categories[contentType]
+func (h *Handler) getCategoryDraftStatus(category ContentCategory) DraftStatusResponse {
+	contentType := category.Type
 	draftBranch := category.DraftBranch
 
-	exists, err := h.repository.BranchExists(draftBranch)
+	exists, err := h.repo(category).BranchExists(draftBranch)
 	if err != nil {
 		return DraftStatusResponse{
 			Category:   string(contentType),
@@ -68,7 +79,7 @@ func (h *Handler) getCategoryDraftStatus(contentType ContentType) DraftStatusRes
 		}
 	}
 
-	changes, err := h.repository.GetBranchChanges(h.mainBranch, draftBranch)
+	comparison, err := h.repo(category).CompareBranches(category.BaseBranch, draftBranch)
 	if err != nil {
 		return DraftStatusResponse{
 			Category:   string(contentType),
@@ -79,33 +90,93 @@ func (h *Handler) getCategoryDraftStatus(contentType ContentType) DraftStatusRes
 		}
 	}
 
-	// Sadece bu kategoriye ait dosyaları filtrele
-	var categoryChanges []ContentChange
-	for _, change := range changes {
-		if strings.HasPrefix(change.Path, category.Path) {
-			categoryChanges = append(categoryChanges, ContentChange{
-				Path:   change.Path,
-				Status: change.Status,
-			})
-		}
-	}
+	categoryChanges := filterCategoryChanges(category, comparison.Changes)
 
-	return DraftStatusResponse{
+	status := DraftStatusResponse{
 		Category:     string(contentType),
 		HasChanges:   len(categoryChanges) > 0,
 		Branch:       draftBranch,
 		ChangedFiles: categoryChanges,
 		TotalFiles:   len(categoryChanges),
+		BehindBy:     comparison.BehindBy,
+		AheadBy:      comparison.AheadBy,
 		CanPublish:   len(categoryChanges) > 0,
 		Message:      fmt.Sprintf("Found %d changed files in %s category", len(categoryChanges), category.Name),
 	}
+
+	// Taslak temel branch'in gerisindeyse, iki tarafta da değişen dosyalar yayınlamayı engelleyebilir
+	if comparison.BehindBy > 0 {
+		conflicts, err := h.findConflictingFiles(category, comparison.Changes)
+		if err != nil {
+			status.Message += fmt.Sprintf(", draft is %d commits behind %s (conflict check failed: %s)", comparison.BehindBy, category.BaseBranch, err.Error())
+			return status
+		}
+
+		status.ConflictingFiles = conflicts
+		if len(conflicts) > 0 {
+			status.CanPublish = false
+			status.Message += fmt.Sprintf(", draft is %d commits behind %s and %d files conflict", comparison.BehindBy, category.BaseBranch, len(conflicts))
+		} else {
+			status.Message += fmt.Sprintf(", draft is %d commits behind %s", comparison.BehindBy, category.BaseBranch)
+		}
+	}
+
+	return status
 }
 
-func (h *Handler) publishCategory(contentType ContentType, message string) map[string]interface{} {
-	category := h.categories[contentType]
+// findConflictingFiles, taslak oluşturulduktan sonra temel branch'te de değişmiş taslak dosyalarını döndürür.
+// GitHub çakışan satırları bildirmediğinden, iki tarafta da değişen her dosya çakışma adayı kabul edilir.
+func (h *Handler) findConflictingFiles(category ContentCategory, draftChanges []GithubService.Change) ([]ConflictFile, error) {
+	mainChanges, err := h.repo(category).GetBranchChanges(category.DraftBranch, category.BaseBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	mainStatus := make(map[string]string, len(mainChanges))
+	for _, change := range mainChanges {
+		mainStatus[change.Path] = change.Status
+		if change.PreviousPath != "" {
+			mainStatus[change.PreviousPath] = change.Status
+		}
+	}
+
+	conflicts := []ConflictFile{}
+	for _, change := range draftChanges {
+		for _, path := range []string{change.Path, change.PreviousPath} {
+			if status, ok := mainStatus[path]; ok && path != "" {
+				conflicts = append(conflicts, ConflictFile{Path: path, DraftStatus: change.Status, MainStatus: status})
+				break
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// filterCategoryChanges, değişikliklerden yalnızca kategoriye ait olanları döndürür.
+// Taşınan dosyalar eski veya yeni yolu kategoride ise dahil edilir.
+func filterCategoryChanges(category ContentCategory, changes []GithubService.Change) []ContentChange {
+	var categoryChanges []ContentChange
+	for _, change := range changes {
+		inCategory := strings.HasPrefix(change.Path, category.Path)
+		if change.PreviousPath != "" && strings.HasPrefix(change.PreviousPath, category.Path) {
+			inCategory = true
+		}
+		if inCategory {
+			categoryChanges = append(categoryChanges, ContentChange{
+				Path:         change.Path,
+				PreviousPath: change.PreviousPath,
+				Status:       change.Status,
+			})
+		}
+	}
+	return categoryChanges
+}
+
+func (h *Handler) publishCategory(category ContentCategory, message string, actor uuid.UUID) map[string]interface{} {
+	contentType := category.Type
 	draftBranch := category.DraftBranch
 
-	exists, err := h.repository.BranchExists(draftBranch)
+	exists, err := h.repo(category).BranchExists(draftBranch)
 	if err != nil || !exists {
 		return map[string]interface{}{
 			"success": false,
@@ -118,25 +189,108 @@ func (h *Handler) publishCategory(contentType ContentType, message string) map[s
 		message = fmt.Sprintf("feat(%s): publish changes from %s", string(contentType), draftBranch)
 	}
 
-	report, err := h.repository.PublishBranchToMain(draftBranch)
+	result, err := h.publishDraft(category, "", message, types.PublishSourceDirect, &actor)
+	var openRequestErr *OpenPublishRequestError
+	if errors.As(err, &openRequestErr) {
+		return map[string]interface{}{
+			"success":  false,
+			"error":    "There is already an open publish request for this draft",
+			"request":  openRequestErr.Request,
+			"category": string(contentType),
+		}
+	}
+	var validationErr *ContentValidationError
+	if errors.As(err, &validationErr) {
+		return map[string]interface{}{
+			"success":  false,
+			"error":    "Draft content validation failed",
+			"details":  validationErr.Error(),
+			"issues":   validationErr.Issues,
+			"category": string(contentType),
+		}
+	}
 	if err != nil {
 		return map[string]interface{}{
 			"success":  false,
 			"error":    "Failed to publish",
-			"details":  report,
+			"details":  "Pull request birleştirilemedi, muhtemelen çakışma var: " + err.Error(),
 			"category": string(contentType),
 		}
 	}
 
-	// Draft branch'ı sil
-	if err := h.repository.DeleteBranch(draftBranch); err != nil {
-		// Log warning but don't fail
-	}
-
 	return map[string]interface{}{
 		"success":  true,
 		"message":  fmt.Sprintf("%s category published successfully", category.Name),
-		"report":   report,
+		"report":   result.Message,
+		"mergeSha": result.SHA,
 		"category": string(contentType),
 	}
 }
+
+// publishDraft, taslak içeriği doğruladıktan sonra taslak branch'i bir Pull Request ile temel branch'e birleştirir,
+// yayını geçmişe kaydeder ve taslak branch'i siler. İçerik geçersizse *ContentValidationError döner. expectedHeadSHA doluysa ve taslak bu commit'ten sonra değiştiyse birleştirme yapılmaz.
+// Taslağın açık bir yayınlama talebi varsa *OpenPublishRequestError döner; aynı branch için ikinci PR açılmaz ve talep onay akışında kalır.
+// Birleştirme başarısız olursa açılan PR kapatılır, böylece sahipsiz PR kalmaz.
+func (h *Handler) publishDraft(category ContentCategory, expectedHeadSHA, message string, source types.PublishSource, actor *uuid.UUID) (*GithubService.MergeResult, error) {
+	openRequest, err := h.contentRepository.GetOpenPublishRequest(context.Background(), string(category.Type), category.DraftBranch)
+	if err != nil {
+		return nil, err
+	}
+	if openRequest != nil {
+		return nil, &OpenPublishRequestError{Request: openRequest}
+	}
+
+	if err := h.checkDraftContent(category); err != nil {
+		return nil, err
+	}
+
+	files := h.draftPublishedFiles(category)
+
+	repository := h.repo(category)
+	title := fmt.Sprintf("feat(%s): publish changes from %s", category.Type, category.DraftBranch)
+	number, _, err := repository.CreatePullRequest(category.BaseBranch, category.DraftBranch, title, message)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := repository.MergePullRequest(number, expectedHeadSHA, title)
+	if err != nil {
+		if closeErr := repository.ClosePullRequest(number); closeErr != nil {
+			log.Printf("[GITHUB] Birleştirilemeyen PR kapatılamadı (#%d): %v", number, closeErr)
+		}
+		return nil, err
+	}
+
+	h.recordPublish(category, source, number, result, files, message, actor)
+
+	if err := repository.DeleteBranch(category.DraftBranch); err != nil {
+		log.Printf("[GITHUB] Yayınlanan taslak branch silinemedi (%s): %v", category.DraftBranch, err)
+	}
+	return result, nil
+}
+
+// currentUserID oturumdaki kullanıcının ID'sini döndürür
+func currentUserID(c *gin.Context) uuid.UUID {
+	userIDVal, _ := c.Get("user_id")
+	userID, _ := userIDVal.(uuid.UUID)
+	return userID
+}
+
+// hasPermission kullanıcının verilen izne sahip olup olmadığını döndürür. Admin her zaman yetkilidir.
+// İzinler PermissionMiddleware tarafından context'e yazılır.
+func hasPermission(c *gin.Context, permission types.Permission) bool {
+	if isAdmin(c) {
+		return true
+	}
+
+	permissions, _ := c.Get("user_permissions")
+	userPermissions, _ := permissions.([]types.Permission)
+	return slices.Contains(userPermissions, permission)
+}
+
+// isAdmin oturumdaki kullanıcının admin rolünde olup olmadığını döndürür
+func isAdmin(c *gin.Context) bool {
+	roleVal, _ := c.Get("user_role")
+	role, ok := roleVal.(types.Role)
+	return ok && role == types.RoleAdmin
+}
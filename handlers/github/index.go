@@ -1,83 +1,87 @@
 package GithubHandler
 
 import (
+	"sync"
+
+	"github.com/google/uuid"
+	ContentRepository "github.com/okanay/backend-template/repositories/content"
+	FileRepository "github.com/okanay/backend-template/repositories/file"
+	AutomationService "github.com/okanay/backend-template/services/automation"
 	GithubRepository "github.com/okanay/backend-template/services/github"
 	ValidationService "github.com/okanay/backend-template/services/validation"
+	"github.com/okanay/backend-template/types"
 )
 
 type ContentType string
 
-const (
-	ContentTypeI18n   ContentType = "i18n"
-	ContentTypeConfig ContentType = "config"
-	ContentTypeTheme  ContentType = "theme"
-)
-
+// ContentCategory, github_content_categories tablosundan yüklenen ve handler'ın kullandığı kategori ayarlarıdır
 type ContentCategory struct {
-	Type        ContentType `json:"type"`
-	Name        string      `json:"name"`
-	Path        string      `json:"path"`
-	Description string      `json:"description"`
-	Extensions  []string    `json:"extensions"`
-	DraftBranch string      `json:"draftBranch"`
-	MaxSize     int64       `json:"maxSize"` // bytes
+	Type        ContentType            `json:"type"`
+	Name        string                 `json:"name"`
+	Path        string                 `json:"path"`
+	Description string                 `json:"description"`
+	Extensions  []string               `json:"extensions"`
+	DraftBranch string                 `json:"draftBranch"`
+	MaxSize     int64                  `json:"maxSize"` // bytes, 0 sınırsız
+	RepoOwner   string                 `json:"repoOwner"`
+	RepoName    string                 `json:"repoName"`
+	BaseBranch  string                 `json:"baseBranch"`
+	Validator   types.ContentValidator `json:"validator"`
+	SchemaPath  string                 `json:"schemaPath,omitempty"` // İçerik reposunda JSON şemalarının bulunduğu klasör
+	UserDrafts  bool                   `json:"userDrafts"`           // Her kullanıcının kendi taslak branch'i vardır
 }
 
 type ContentChange struct {
-	Path   string `json:"path"`
-	Status string `json:"status"` // "added", "modified", "deleted"
+	Path         string `json:"path"`
+	PreviousPath string `json:"previousPath,omitempty"` // Yalnızca "renamed" durumunda dolu
+	Status       string `json:"status"`                 // "added", "modified", "deleted", "renamed"
+}
+
+// ConflictFile, hem taslakta hem de taslak oluşturulduktan sonra main'de değişmiş bir dosyadır
+type ConflictFile struct {
+	Path        string `json:"path"`
+	DraftStatus string `json:"draftStatus"`
+	MainStatus  string `json:"mainStatus"`
 }
 
 type DraftStatusResponse struct {
-	Category     string          `json:"category"`
-	HasChanges   bool            `json:"hasChanges"`
-	Branch       string          `json:"branch,omitempty"`
-	ChangedFiles []ContentChange `json:"changedFiles"`
-	TotalFiles   int             `json:"totalFiles"`
-	CanPublish   bool            `json:"canPublish"`
-	Message      string          `json:"message"`
+	Category         string          `json:"category"`
+	HasChanges       bool            `json:"hasChanges"`
+	Branch           string          `json:"branch,omitempty"`
+	ChangedFiles     []ContentChange `json:"changedFiles"`
+	TotalFiles       int             `json:"totalFiles"`
+	BehindBy         int             `json:"behindBy"`                   // Taslakta olmayan main commit'lerinin sayısı
+	AheadBy          int             `json:"aheadBy"`                    // Main'de olmayan taslak commit'lerinin sayısı
+	ConflictingFiles []ConflictFile  `json:"conflictingFiles,omitempty"` // Çakışma ihtimali olan dosyalar
+	CanPublish       bool            `json:"canPublish"`
+	Message          string          `json:"message"`
+}
+
+// OpenDraft, bir kategorideki açık taslaklardan biridir. Paylaşılan taslakta UserID boştur.
+type OpenDraft struct {
+	UserID *uuid.UUID          `json:"userId,omitempty"`
+	Status DraftStatusResponse `json:"status"`
 }
 
 type Handler struct {
-	repository        *GithubRepository.Service
-	mainBranch        string
-	categories        map[ContentType]ContentCategory
+	repository        *GithubRepository.Service // Varsayılan repo; kategoriler farklı bir repo belirtebilir
+	categoriesMu      sync.RWMutex
+	categories        map[ContentType]ContentCategory // Yalnızca etkin kategoriler, ReloadCategories ile yenilenir
+	contentRepository *ContentRepository.Repository   // Kategoriler, yayınlama talepleri ve zamanlamaları
+	automationService *AutomationService.AutomationService
+	fileRepository    *FileRepository.Repository // İçeriklerde kullanılan dosyaların referans indeksi için
 	validationService *ValidationService.Service
+	schemas           *contentSchemas
 }
 
-func NewHandler(r *GithubRepository.Service, validationService *ValidationService.Service) *Handler {
+func NewHandler(r *GithubRepository.Service, contentRepository *ContentRepository.Repository, fileRepository *FileRepository.Repository, automationService *AutomationService.AutomationService, validationService *ValidationService.Service) *Handler {
 	return &Handler{
 		validationService: validationService,
 		repository:        r,
-		mainBranch:        "main",
-		categories: map[ContentType]ContentCategory{
-			ContentTypeI18n: {
-				Type:        ContentTypeI18n,
-				Name:        "Internationalization",
-				Path:        "src/messages",
-				Description: "Translation files for different languages",
-				Extensions:  []string{".json"},
-				DraftBranch: "i18n-draft",
-				MaxSize:     1024 * 1024, // 1MB
-			},
-			ContentTypeConfig: {
-				Type:        ContentTypeConfig,
-				Name:        "Configuration",
-				Path:        "src/config",
-				Description: "Application configuration files",
-				Extensions:  []string{".json", ".js", ".ts"},
-				DraftBranch: "config-draft",
-				MaxSize:     512 * 1024, // 512KB
-			},
-			ContentTypeTheme: {
-				Type:        ContentTypeTheme,
-				Name:        "Theme & Styling",
-				Path:        "src/styles",
-				Description: "CSS and SCSS styling files",
-				Extensions:  []string{".css", ".scss"},
-				DraftBranch: "theme-draft",
-				MaxSize:     2 * 1024 * 1024, // 2MB
-			},
-		},
+		contentRepository: contentRepository,
+		automationService: automationService,
+		fileRepository:    fileRepository,
+		schemas:           newContentSchemas(),
+		categories:        make(map[ContentType]ContentCategory),
 	}
 }
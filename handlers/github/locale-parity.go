@@ -0,0 +1,253 @@
+package GithubHandler
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// localeFile, bir dil dosyasının dilini ve dil içindeki yolunu tutar.
+// "src/messages/tr.json" için dil "tr" ve ad alanı boştur; "src/messages/tr/common.json" için ad alanı "common.json" olur.
+type localeFile struct {
+	Path      string
+	SHA       string
+	Locale    string
+	Namespace string
+}
+
+// checkLocaleParity, verilen commit'teki her dil dosyasının referans dildeki karşılığı ile aynı anahtarlara ve
+// aynı ICU argümanlarına sahip olduğunu doğrular. scope doluysa yalnızca bu yollardaki dosyalar ve bu yollardaki
+// referans dosyalarının karşılıkları kontrol edilir; boşsa kategorideki tüm dil dosyaları kontrol edilir.
+func (h *Handler) checkLocaleParity(category ContentCategory, ref string, scope []string) ([]ContentIssue, error) {
+	treeFiles, err := h.repo(category).ListFiles(ref, category.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	referenceLocale := configs.GetGithubI18nReferenceLocale()
+	references := make(map[string]localeFile)
+	var locales []localeFile
+	localeNames := make(map[string]bool)
+
+	for _, treeFile := range treeFiles {
+		if path.Ext(treeFile.Path) != ".json" {
+			continue
+		}
+		file := parseLocaleFile(category, treeFile.Path, treeFile.SHA)
+		localeNames[file.Locale] = true
+		if file.Locale == referenceLocale {
+			references[file.Namespace] = file
+		} else {
+			locales = append(locales, file)
+		}
+	}
+
+	if len(references) == 0 {
+		if len(locales) == 0 {
+			return nil, nil
+		}
+		return []ContentIssue{{
+			Path:    category.Path,
+			Message: fmt.Sprintf("reference locale %q has no files", referenceLocale),
+		}}, nil
+	}
+
+	inScope := func(paths ...string) bool {
+		if len(scope) == 0 {
+			return true
+		}
+		for _, p := range paths {
+			if slices.Contains(scope, p) {
+				return true
+			}
+		}
+		return false
+	}
+
+	contents := make(map[string][]byte)
+	readFile := func(file localeFile) ([]byte, error) {
+		if content, ok := contents[file.SHA]; ok {
+			return content, nil
+		}
+		content, err := h.repo(category).GetBlob(file.SHA)
+		if err != nil {
+			return nil, err
+		}
+		contents[file.SHA] = content
+		return content, nil
+	}
+
+	var issues []ContentIssue
+	present := make(map[string]bool, len(locales))
+	for _, file := range locales {
+		present[file.Locale+"/"+file.Namespace] = true
+
+		reference, ok := references[file.Namespace]
+		if !inScope(file.Path, reference.Path) {
+			continue
+		}
+		if !ok {
+			issues = append(issues, ContentIssue{
+				Path:    file.Path,
+				Message: fmt.Sprintf("file has no counterpart in reference locale %q", referenceLocale),
+			})
+			continue
+		}
+
+		referenceContent, err := readFile(reference)
+		if err != nil {
+			return nil, err
+		}
+		content, err := readFile(file)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, compareLocaleContent(file.Path, referenceContent, content, referenceLocale)...)
+	}
+
+	// Ad alanlı yapıda, referans dildeki her dosyanın diğer dillerde de bulunması gerekir
+	for locale := range localeNames {
+		if locale == referenceLocale {
+			continue
+		}
+		for namespace, reference := range references {
+			if namespace == "" || present[locale+"/"+namespace] || !inScope(reference.Path) {
+				continue
+			}
+			issues = append(issues, ContentIssue{
+				Path:    path.Join(category.Path, locale, namespace),
+				Message: fmt.Sprintf("file is missing, it exists in reference locale %q", referenceLocale),
+			})
+		}
+	}
+
+	slices.SortStableFunc(issues, func(a, b ContentIssue) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return issues, nil
+}
+
+func parseLocaleFile(category ContentCategory, filePath, sha string) localeFile {
+	relative := strings.TrimPrefix(strings.TrimPrefix(filePath, category.Path), "/")
+	locale, namespace, nested := strings.Cut(relative, "/")
+	if !nested {
+		return localeFile{Path: filePath, SHA: sha, Locale: strings.TrimSuffix(relative, path.Ext(relative))}
+	}
+	return localeFile{Path: filePath, SHA: sha, Locale: locale, Namespace: namespace}
+}
+
+// compareLocaleContent, dil dosyasını referans dosyayla anahtar, değer türü ve ICU argümanları bakımından karşılaştırır
+func compareLocaleContent(filePath string, referenceContent, content []byte, referenceLocale string) []ContentIssue {
+	reference, _, referenceErr := utils.ParseJSONWithPositions(referenceContent)
+	if referenceErr != nil {
+		// Referans dosyanın kendi hataları ayrıca raporlanır; geçersiz referansla karşılaştırma yapılamaz
+		return nil
+	}
+
+	document, positions, syntaxErr := utils.ParseJSONWithPositions(content)
+	if syntaxErr != nil {
+		return []ContentIssue{{Path: filePath, Line: syntaxErr.Line, Column: syntaxErr.Column, Message: syntaxErr.Message}}
+	}
+
+	referenceKeys := utils.FlattenJSON(reference)
+	keys := utils.FlattenJSON(document)
+
+	var issues []ContentIssue
+	issue := func(pointer, format string, args ...any) {
+		position := nearestPosition(positions, pointer)
+		issues = append(issues, ContentIssue{
+			Path:    filePath,
+			Pointer: pointer,
+			Line:    position.Line,
+			Column:  position.Column,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, pointer := range slices.Sorted(maps.Keys(referenceKeys)) {
+		value, ok := keys[pointer]
+		if !ok {
+			issue(pointer, "missing key %q, it exists in reference locale %q", utils.JSONPointerPath(pointer), referenceLocale)
+			continue
+		}
+
+		referenceValue := referenceKeys[pointer]
+		if reflect.TypeOf(value) != reflect.TypeOf(referenceValue) {
+			issue(pointer, "key %q has a different type than in reference locale %q", utils.JSONPointerPath(pointer), referenceLocale)
+			continue
+		}
+
+		referenceMessage, isString := referenceValue.(string)
+		if !isString {
+			continue
+		}
+		referenceArguments, err := utils.ICUArguments(referenceMessage)
+		if err != nil {
+			continue
+		}
+		arguments, err := utils.ICUArguments(value.(string))
+		if err != nil {
+			issue(pointer, "invalid ICU message: %s", err.Error())
+			continue
+		}
+		if !slices.Equal(arguments, referenceArguments) {
+			issue(pointer, "placeholders %s do not match reference locale %q placeholders %s",
+				formatPlaceholders(arguments), referenceLocale, formatPlaceholders(referenceArguments))
+		}
+	}
+
+	for _, pointer := range slices.Sorted(maps.Keys(keys)) {
+		if _, ok := referenceKeys[pointer]; !ok {
+			issue(pointer, "key %q does not exist in reference locale %q", utils.JSONPointerPath(pointer), referenceLocale)
+		}
+	}
+
+	return issues
+}
+
+// nearestPosition, yolun kendisi belgede yoksa (eksik anahtar) en yakın üst anahtarın konumunu döndürür
+func nearestPosition(positions map[string]utils.JSONPosition, pointer string) utils.JSONPosition {
+	for {
+		if position, ok := positions[pointer]; ok {
+			return position
+		}
+		index := strings.LastIndex(pointer, "/")
+		if index < 0 {
+			return utils.JSONPosition{}
+		}
+		pointer = pointer[:index]
+	}
+}
+
+func formatPlaceholders(arguments []string) string {
+	if len(arguments) == 0 {
+		return "(none)"
+	}
+	return "{" + strings.Join(arguments, "}, {") + "}"
+}
+
+// localeParityWarnings, kaydedilen dil dosyalarının referans dille uyumsuzluklarını döndürür.
+// Çeviriler genellikle dil dil güncellendiğinden kayıt engellenmez; uyumsuzluklar yalnızca yayınlamayı engeller.
+func (h *Handler) localeParityWarnings(category ContentCategory, ref string, paths []string) []ContentIssue {
+	if category.Validator != types.ContentValidatorI18n {
+		return []ContentIssue{}
+	}
+
+	issues, err := h.checkLocaleParity(category, ref, paths)
+	if err != nil {
+		log.Printf("[GITHUB] Dil dosyası uyumu kontrol edilemedi (%s): %v", category.Type, err)
+		return []ContentIssue{}
+	}
+	if issues == nil {
+		return []ContentIssue{}
+	}
+	return issues
+}
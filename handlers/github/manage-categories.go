@@ -0,0 +1,376 @@
+package GithubHandler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/types"
+)
+
+// categoryTypePattern, kategori adının rotalarda kullanılabilecek bir kısa ad olmasını sağlar (ör. "i18n", "landing-config")
+var categoryTypePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// reservedCategoryTypes, /v1/github altındaki sabit rotalarla çakışan kategori adlarıdır
+var reservedCategoryTypes = []string{"categories", "publish-requests", "publish-schedules", "health"}
+
+// ListAllCategories, devre dışı olanlar dahil tüm kategorileri veritabanındaki ayarlarıyla döndürür
+func (h *Handler) ListAllCategories(c *gin.Context) {
+	categories, err := h.contentRepository.ListContentCategories(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not list content categories",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"categories": categories,
+	})
+}
+
+// CreateCategory yeni bir içerik kategorisi oluşturur ve kategorileri yeniden yükler
+func (h *Handler) CreateCategory(c *gin.Context) {
+	var req types.ContentCategoryRequest
+	if h.validationService.Validate(c, &req) != nil {
+		return
+	}
+
+	input, err := h.categoryInput(req.Type, req, nil)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category", "details": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	existing, err := h.contentRepository.GetContentCategory(ctx, input.Type)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not check content category", "details": err.Error()})
+		return
+	}
+	if existing != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Content category already exists"})
+		return
+	}
+
+	if !h.checkCategoryBranchConflicts(c, input) {
+		return
+	}
+
+	category, err := h.contentRepository.CreateContentCategory(ctx, input)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not create content category", "details": err.Error()})
+		return
+	}
+
+	h.reloadCategoriesLogged()
+	log.Printf("[GITHUB] İçerik kategorisi oluşturuldu: %s (%s)", category.Type, category.DraftBranch)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":  true,
+		"category": category,
+	})
+}
+
+// UpdateCategory kategorinin ayarlarını günceller. Açık taslağı olan kategorinin reposu, branch'leri veya
+// kişisel taslak modu değiştirilemez; aksi halde taslaklar sahipsiz kalır.
+func (h *Handler) UpdateCategory(c *gin.Context) {
+	var req types.ContentCategoryRequest
+	if h.validationService.Validate(c, &req) != nil {
+		return
+	}
+
+	ctx := c.Request.Context()
+	current, err := h.contentRepository.GetContentCategory(ctx, c.Param("type"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not load content category", "details": err.Error()})
+		return
+	}
+	if current == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Content category not found"})
+		return
+	}
+
+	input, err := h.categoryInput(current.Type, req, current)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category", "details": err.Error()})
+		return
+	}
+
+	if !h.checkCategoryBranchConflicts(c, input) {
+		return
+	}
+
+	previous, updated := h.toContentCategory(*current), h.toContentCategory(types.ContentCategory{
+		Type:        input.Type,
+		RepoOwner:   input.RepoOwner,
+		RepoName:    input.RepoName,
+		BaseBranch:  input.BaseBranch,
+		DraftBranch: input.DraftBranch,
+		UserDrafts:  input.UserDrafts,
+	})
+	// Git "i18n-draft" ve "i18n-draft/<userId>" branch'lerinin birlikte var olmasına izin vermediğinden
+	// kişisel taslak modu da yalnızca açık taslak yokken değiştirilebilir
+	if previous.RepoOwner != updated.RepoOwner || previous.RepoName != updated.RepoName ||
+		previous.BaseBranch != updated.BaseBranch || previous.DraftBranch != updated.DraftBranch ||
+		previous.UserDrafts != updated.UserDrafts {
+		drafts, err := h.draftBranches(previous)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "Could not check for draft branches", "details": err.Error()})
+			return
+		}
+		if len(drafts) > 0 {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":  "Category has open drafts, publish or discard them before changing its repository, branches or draft mode",
+				"drafts": drafts,
+			})
+			return
+		}
+	}
+
+	category, err := h.contentRepository.UpdateContentCategory(ctx, input)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Content category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not update content category", "details": err.Error()})
+		return
+	}
+
+	h.reloadCategoriesLogged()
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"category": category,
+	})
+}
+
+// DeleteCategory kategoriyi siler. Yayın geçmişi ve talepler korunur; açık taslakları olan kategori silinemez.
+func (h *Handler) DeleteCategory(c *gin.Context) {
+	ctx := c.Request.Context()
+	current, err := h.contentRepository.GetContentCategory(ctx, c.Param("type"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not load content category", "details": err.Error()})
+		return
+	}
+	if current == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Content category not found"})
+		return
+	}
+
+	drafts, err := h.draftBranches(h.toContentCategory(*current))
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Could not check for draft branches", "details": err.Error()})
+		return
+	}
+	if len(drafts) > 0 {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":  "Category has open drafts, publish or discard them before deleting the category",
+			"drafts": drafts,
+		})
+		return
+	}
+
+	if err := h.contentRepository.DeleteContentCategory(ctx, current.Type); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Content category not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not delete content category", "details": err.Error()})
+		return
+	}
+
+	h.reloadCategoriesLogged()
+	log.Printf("[GITHUB] İçerik kategorisi silindi: %s", current.Type)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  fmt.Sprintf("%s category deleted", current.Type),
+		"category": current.Type,
+	})
+}
+
+// ReloadContentCategories kategorileri veritabanından yeniden yükler.
+// Veritabanı doğrudan düzenlendiğinde periyodik yenilemeyi beklemeden değişiklikleri uygulamak için kullanılır.
+func (h *Handler) ReloadContentCategories(c *gin.Context) {
+	if err := h.ReloadCategories(c.Request.Context()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not reload content categories", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"categories": h.categoryList(),
+	})
+}
+
+// categoryInput isteği doğrulayıp veritabanına yazılacak hale getirir. current doluysa güncellenen kayıttır.
+func (h *Handler) categoryInput(categoryType string, req types.ContentCategoryRequest, current *types.ContentCategory) (types.ContentCategoryInput, error) {
+	categoryType = strings.TrimSpace(categoryType)
+	if current == nil {
+		if !categoryTypePattern.MatchString(categoryType) {
+			return types.ContentCategoryInput{}, fmt.Errorf("type must contain only lowercase letters, digits and dashes")
+		}
+		if slices.Contains(reservedCategoryTypes, categoryType) {
+			return types.ContentCategoryInput{}, fmt.Errorf("type %q is reserved", categoryType)
+		}
+	}
+
+	input := types.ContentCategoryInput{
+		Type:        categoryType,
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		RepoOwner:   optionalTrimmed(req.RepoOwner),
+		RepoName:    optionalTrimmed(req.RepoName),
+		BaseBranch:  strings.TrimSpace(req.BaseBranch),
+		Path:        strings.Trim(strings.TrimSpace(req.Path), "/"),
+		MaxSize:     req.MaxSize,
+		Validator:   req.Validator,
+		DraftBranch: strings.TrimSpace(req.DraftBranch),
+		UserDrafts:  req.UserDrafts,
+		Enabled:     true,
+	}
+
+	if input.Name == "" {
+		return input, fmt.Errorf("name is required")
+	}
+	if input.BaseBranch == "" {
+		input.BaseBranch = "main"
+	}
+	if input.DraftBranch == "" {
+		input.DraftBranch = categoryType + "-draft"
+	}
+	if input.Validator == "" {
+		input.Validator = types.ContentValidatorNone
+	}
+	if req.Enabled != nil {
+		input.Enabled = *req.Enabled
+	} else if current != nil {
+		input.Enabled = current.Enabled
+	}
+
+	if err := checkRepositoryPath(input.Path); err != nil {
+		return input, fmt.Errorf("path: %w", err)
+	}
+	if schemaPath := optionalTrimmed(req.SchemaPath); schemaPath != nil {
+		trimmed := strings.Trim(*schemaPath, "/")
+		if err := checkRepositoryPath(trimmed); err != nil {
+			return input, fmt.Errorf("schemaPath: %w", err)
+		}
+		input.SchemaPath = &trimmed
+	}
+
+	for _, branch := range []string{input.BaseBranch, input.DraftBranch} {
+		if !isValidBranchName(branch) {
+			return input, fmt.Errorf("%q is not a valid branch name", branch)
+		}
+	}
+	if branchesOverlap(input.BaseBranch, input.DraftBranch) {
+		return input, fmt.Errorf("draft branch must differ from base branch %q", input.BaseBranch)
+	}
+
+	for _, extension := range req.Extensions {
+		extension = strings.ToLower(strings.TrimSpace(extension))
+		if len(extension) < 2 || !strings.HasPrefix(extension, ".") || strings.ContainsAny(extension, "/\\ ") {
+			return input, fmt.Errorf("extension %q must look like \".json\"", extension)
+		}
+		if !slices.Contains(input.Extensions, extension) {
+			input.Extensions = append(input.Extensions, extension)
+		}
+	}
+
+	return input, nil
+}
+
+// checkCategoryBranchConflicts, kategorinin branch'lerinin aynı repodaki diğer kategorilerin branch'leriyle
+// çakışmadığını doğrular. Aksi halde bir kategoriyi yayınlamak diğerinin taslağını birleştirir veya siler.
+// Çakışma varsa 409 yanıtını yazar ve false döndürür.
+func (h *Handler) checkCategoryBranchConflicts(c *gin.Context, input types.ContentCategoryInput) bool {
+	records, err := h.contentRepository.ListContentCategories(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not list content categories", "details": err.Error()})
+		return false
+	}
+
+	category := h.toContentCategory(types.ContentCategory{
+		Type:        input.Type,
+		RepoOwner:   input.RepoOwner,
+		RepoName:    input.RepoName,
+		BaseBranch:  input.BaseBranch,
+		DraftBranch: input.DraftBranch,
+	})
+
+	for _, record := range records {
+		other := h.toContentCategory(record)
+		if other.Type == category.Type || other.RepoOwner != category.RepoOwner || other.RepoName != category.RepoName {
+			continue
+		}
+		if branchesOverlap(category.DraftBranch, other.DraftBranch) ||
+			branchesOverlap(category.DraftBranch, other.BaseBranch) ||
+			branchesOverlap(category.BaseBranch, other.DraftBranch) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "Branch conflicts with another content category in the same repository",
+				"details": fmt.Sprintf("category %q uses %s and %s", other.Type, other.BaseBranch, other.DraftBranch),
+			})
+			return false
+		}
+	}
+	return true
+}
+
+// checkRepositoryPath, repodaki bir klasör yolunun göreli ve üst klasöre çıkmayan bir yol olduğunu doğrular
+func checkRepositoryPath(dirPath string) error {
+	if dirPath == "" {
+		return fmt.Errorf("must not be empty")
+	}
+	for _, segment := range strings.Split(dirPath, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("%q is not a valid repository path", dirPath)
+		}
+	}
+	return nil
+}
+
+// isValidBranchName, git'in ref adı kurallarının sık karşılaşılan kısmını uygular
+func isValidBranchName(branch string) bool {
+	if branch == "" || branch == "@" || strings.ContainsAny(branch, " ~^:?*[\\") ||
+		strings.Contains(branch, "..") || strings.Contains(branch, "//") || strings.Contains(branch, "@{") {
+		return false
+	}
+	if strings.HasPrefix(branch, "/") || strings.HasSuffix(branch, "/") || strings.HasSuffix(branch, ".") ||
+		strings.HasSuffix(branch, ".lock") || strings.HasPrefix(branch, "-") {
+		return false
+	}
+	for _, segment := range strings.Split(branch, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+// branchesOverlap, iki branch'in aynı olduğunu veya birinin diğerinin klasörü olduğunu bildirir.
+// Git "a" ve "a/b" ref'lerinin birlikte var olmasına izin vermez.
+func branchesOverlap(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+func optionalTrimmed(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -0,0 +1,104 @@
+package GithubHandler
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+	GithubService "github.com/okanay/backend-template/services/github"
+)
+
+// MoveContent, bir dosyayı taslak branch'te yeniden adlandırır veya kategori içinde başka bir klasöre taşır.
+// Dosya içeriği yeniden yüklenmez; mevcut blob yeni yola bağlanır ve eski yol aynı commit'te silinir.
+func (h *Handler) MoveContent(c *gin.Context) {
+	var req struct {
+		From    string `json:"from" binding:"required"`
+		To      string `json:"to" binding:"required,nefield=From"`
+		Message string `json:"message"`
+		HeadSHA string `json:"headSha"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	contentType := ContentType(c.Param("category"))
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
+		return
+	}
+
+	// Güvenlik kontrolleri hem kaynak hem hedef yol için uygulanır
+	for _, path := range []string{req.From, req.To} {
+		if err := h.checkContentPath(category, path); err != nil {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": fmt.Sprintf("%s: %s", path, err.Error()),
+			})
+			return
+		}
+	}
+
+	if err := h.ensureDraftBranch(category); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not create draft branch",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	draftBranch := category.DraftBranch
+	existing, err := h.categoryFileIndex(category, draftBranch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not list files",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	blobSHA, ok := existing[req.From]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found", "path": req.From})
+		return
+	}
+	if _, ok := existing[req.To]; ok {
+		c.JSON(http.StatusConflict, gin.H{"error": "A file already exists at the target path", "path": req.To})
+		return
+	}
+
+	commitMessage := req.Message
+	if commitMessage == "" {
+		commitMessage = fmt.Sprintf("feat(%s): rename %s to %s", contentType, filepath.Base(req.From), filepath.Base(req.To))
+	}
+
+	changes := []GithubService.FileChange{
+		{Path: req.To, SHA: blobSHA},
+		{Path: req.From, Delete: true},
+	}
+	commitSHA, ok := h.commitChanges(c, category, draftBranch, req.HeadSHA, changes, commitMessage)
+	if !ok {
+		return
+	}
+
+	// Referans indeksi eski yoldan yeni yola taşınır
+	h.updateFileReferences(contentType, draftBranch, req.From, "")
+	if content, err := h.repo(category).GetBlob(blobSHA); err == nil {
+		h.updateFileReferences(contentType, draftBranch, req.To, string(content))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":   fmt.Sprintf("%s moved to %s on %s", req.From, req.To, draftBranch),
+		"sha":      commitSHA,
+		"blobSha":  blobSHA,
+		"branch":   draftBranch,
+		"category": contentType,
+		"from":     req.From,
+		"to":       req.To,
+		"success":  true,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
 )
 
 func (h *Handler) PublishCategory(c *gin.Context) {
@@ -15,12 +16,24 @@ func (h *Handler) PublishCategory(c *gin.Context) {
 	c.ShouldBindJSON(&req) // Optional message
 
 	contentType := ContentType(categoryParam)
-	_, exists := h.categories[contentType]
-	if !exists {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
 		return
 	}
 
-	result := h.publishCategory(contentType, req.Message)
+	// Onay modu açıksa değişiklikler yalnızca onaylanan yayınlama talepleriyle yayınlanabilir
+	if configs.GetGithubPublishApprovalRequired() {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   "Publishing requires approval",
+			"details": "Create a publish request with POST /v1/github/" + categoryParam + "/publish-requests",
+		})
+		return
+	}
+
+	result := h.publishCategory(category, req.Message, currentUserID(c))
+	if _, hasOpenRequest := result["request"]; hasOpenRequest {
+		c.JSON(http.StatusConflict, result)
+		return
+	}
 	c.JSON(http.StatusOK, result)
 }
@@ -0,0 +1,268 @@
+package GithubHandler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	GithubService "github.com/okanay/backend-template/services/github"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetPublishHistory kategorinin yayın ve geri alma geçmişini en yeniden eskiye doğru listeler
+func (h *Handler) GetPublishHistory(c *gin.Context) {
+	contentType := ContentType(c.Param("category"))
+	if _, exists := h.category(contentType); !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 500 {
+		limit = 50
+	}
+
+	records, err := h.contentRepository.ListPublishRecords(c.Request.Context(), string(contentType), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not get publish history",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"category": contentType,
+		"history":  records,
+	})
+}
+
+// RollbackPublish, bir yayında değişen dosyaları yayından önceki hallerine döndüren bir commit'i doğrudan main'e yazar.
+// Yayında eklenen dosyalar silinir, değiştirilen veya silinen dosyalar geri yüklenir, taşınan dosyalar eski yoluna döner.
+// Yayından sonra bu dosyalarda yapılan değişiklikler de geri alınır.
+func (h *Handler) RollbackPublish(c *gin.Context) {
+	var req struct {
+		Message string `json:"message" binding:"max=2000"`
+	}
+	c.ShouldBindJSON(&req) // Optional message
+
+	contentType := ContentType(c.Param("category"))
+	category, exists := h.category(contentType)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+		return
+	}
+
+	publishID, err := uuid.Parse(c.Param("publishId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid publish ID"})
+		return
+	}
+
+	// Onay modunda geri alma da main'i doğrudan değiştirdiği için onay yetkisi gerektirir
+	if configs.GetGithubPublishApprovalRequired() && !hasPermission(c, types.CanApproveGithubPublish) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":               "Rollback requires approval permission",
+			"required_permission": types.CanApproveGithubPublish,
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+	record, err := h.contentRepository.GetPublishRecordByID(ctx, publishID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not get publish record",
+			"details": err.Error(),
+		})
+		return
+	}
+	if record == nil || record.Category != string(contentType) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Publish record not found"})
+		return
+	}
+	if record.Source == types.PublishSourceRollback {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A rollback cannot be rolled back, publish the changes again instead"})
+		return
+	}
+	if record.BaseSHA == "" {
+		c.JSON(http.StatusConflict, gin.H{"error": "Pre-publish state of this publish is unknown"})
+		return
+	}
+
+	// Aynı yayının eşzamanlı olarak iki kez geri alınmasını engellemek için önce işaretlenir
+	if err := h.contentRepository.MarkPublishRecordRolledBack(ctx, record.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusConflict, gin.H{"error": "This publish has already been rolled back"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not update publish record",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	rollback, status, response := h.rollbackPublish(category, *record, req.Message, currentUserID(c))
+	if rollback == nil {
+		if err := h.contentRepository.UnmarkPublishRecordRolledBack(context.Background(), record.ID); err != nil {
+			log.Printf("[GITHUB] Geri alma işareti kaldırılamadı (id: %s): %v", record.ID, err)
+		}
+		c.JSON(status, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  fmt.Sprintf("Publish %s rolled back", record.ID),
+		"rollback": rollback,
+	})
+}
+
+// rollbackPublish geri alma commit'ini oluşturur ve geçmişe kaydeder.
+// Başarısız olursa nil ile birlikte yazılacak HTTP durumunu ve yanıtı döndürür.
+func (h *Handler) rollbackPublish(category ContentCategory, record types.PublishRecord, message string, actor uuid.UUID) (*types.PublishRecord, int, gin.H) {
+	headSHA, err := h.repo(category).GetBranchHead(category.BaseBranch)
+	if err != nil || headSHA == "" {
+		return nil, http.StatusInternalServerError, gin.H{"error": "Could not resolve base branch head"}
+	}
+
+	baseFiles, err := h.fileIndexAt(category, record.BaseSHA, category.Path)
+	if err != nil {
+		return nil, http.StatusInternalServerError, gin.H{"error": "Could not read pre-publish files", "details": err.Error()}
+	}
+	currentFiles, err := h.fileIndexAt(category, headSHA, category.Path)
+	if err != nil {
+		return nil, http.StatusInternalServerError, gin.H{"error": "Could not read current files", "details": err.Error()}
+	}
+
+	var changes []GithubService.FileChange
+	var restored []types.PublishedFile
+	deleteIfExists := func(path string) {
+		if _, ok := currentFiles[path]; ok {
+			changes = append(changes, GithubService.FileChange{Path: path, Delete: true})
+			restored = append(restored, types.PublishedFile{Path: path, Status: "deleted"})
+		}
+	}
+	restore := func(path string) {
+		baseSHA, ok := baseFiles[path]
+		if !ok || currentFiles[path] == baseSHA {
+			return
+		}
+		status := "modified"
+		if _, exists := currentFiles[path]; !exists {
+			status = "added"
+		}
+		changes = append(changes, GithubService.FileChange{Path: path, SHA: baseSHA})
+		restored = append(restored, types.PublishedFile{Path: path, Status: status})
+	}
+
+	for _, file := range record.Files {
+		switch file.Status {
+		case "added":
+			deleteIfExists(file.Path)
+		case "renamed":
+			deleteIfExists(file.Path)
+			restore(file.PreviousPath)
+		default:
+			restore(file.Path)
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, http.StatusConflict, gin.H{"error": "Files are already in their pre-publish state"}
+	}
+
+	if message == "" {
+		message = fmt.Sprintf("revert(%s): roll back publish %s", category.Type, record.MergeSHA)
+	}
+
+	commitSHA, err := h.repo(category).CommitFiles(category.BaseBranch, headSHA, changes, message)
+	if err != nil {
+		if errors.Is(err, GithubService.ErrBranchHeadMismatch) {
+			return nil, http.StatusConflict, gin.H{"error": "Base branch changed during rollback, try again"}
+		}
+		return nil, http.StatusInternalServerError, gin.H{"error": "Could not commit rollback", "details": err.Error()}
+	}
+
+	rollback, err := h.contentRepository.CreatePublishRecord(context.Background(), types.CreatePublishRecordInput{
+		Category:    string(category.Type),
+		Source:      types.PublishSourceRollback,
+		MergeSHA:    commitSHA,
+		BaseSHA:     headSHA,
+		Files:       restored,
+		Message:     &message,
+		PublishedBy: &actor,
+		RollbackOf:  &record.ID,
+	})
+	if err != nil {
+		// Commit main'e yazıldığı için işlem başarılıdır; yalnızca geçmiş kaydı eksik kalır
+		log.Printf("[GITHUB] Geri alma kaydı oluşturulamadı (commit: %s): %v", commitSHA, err)
+		return &types.PublishRecord{Category: string(category.Type), Source: types.PublishSourceRollback, MergeSHA: commitSHA, BaseSHA: headSHA, Files: restored, RollbackOf: &record.ID}, 0, nil
+	}
+	return rollback, 0, nil
+}
+
+// draftPublishedFiles, taslak branch'te main'e göre değişen kategori dosyalarını yayın kaydı için döndürür.
+// Hata durumunda yayın engellenmez, kayıt dosya listesi olmadan oluşturulur.
+func (h *Handler) draftPublishedFiles(category ContentCategory) []types.PublishedFile {
+	changes, err := h.repo(category).GetBranchChanges(category.BaseBranch, category.DraftBranch)
+	if err != nil {
+		log.Printf("[GITHUB] Yayınlanacak dosyalar okunamadı (%s): %v", category.Type, err)
+		return nil
+	}
+
+	files := []types.PublishedFile{}
+	for _, change := range filterCategoryChanges(category, changes) {
+		files = append(files, types.PublishedFile{Path: change.Path, PreviousPath: change.PreviousPath, Status: change.Status})
+	}
+	return files
+}
+
+// recordPublish, main'e alınan yayını geçmişe kaydeder. Yayından önceki main commit'i, merge commit'inin ilk ebeveynidir.
+func (h *Handler) recordPublish(category ContentCategory, source types.PublishSource, prNumber int, result *GithubService.MergeResult, files []types.PublishedFile, message string, actor *uuid.UUID) {
+	baseSHA, err := h.repo(category).GetCommitParent(result.SHA)
+	if err != nil {
+		log.Printf("[GITHUB] Yayından önceki commit okunamadı (merge: %s): %v", result.SHA, err)
+	}
+
+	input := types.CreatePublishRecordInput{
+		Category:    string(category.Type),
+		Source:      source,
+		PRNumber:    &prNumber,
+		MergeSHA:    result.SHA,
+		BaseSHA:     baseSHA,
+		Files:       files,
+		Report:      &result.Message,
+		PublishedBy: actor,
+	}
+	if message != "" {
+		input.Message = &message
+	}
+
+	if _, err := h.contentRepository.CreatePublishRecord(context.Background(), input); err != nil {
+		log.Printf("[GITHUB] Yayın kaydı oluşturulamadı (merge: %s): %v", result.SHA, err)
+	}
+}
+
+// fileIndexAt, verilen commit'te klasör altındaki dosyaları yola göre blob SHA'larıyla döndürür
+func (h *Handler) fileIndexAt(category ContentCategory, ref, dirPath string) (map[string]string, error) {
+	files, err := h.repo(category).ListFiles(ref, dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]string, len(files))
+	for _, file := range files {
+		index[file.Path] = file.SHA
+	}
+	return index, nil
+}
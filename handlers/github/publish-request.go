@@ -0,0 +1,414 @@
+package GithubHandler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	GithubService "github.com/okanay/backend-template/services/github"
+	"github.com/okanay/backend-template/types"
+)
+
+// OpenPublishRequestError, taslağın onay bekleyen veya birleştirilmekte olan bir yayınlama talebi olduğunu bildirir
+type OpenPublishRequestError struct {
+	Request *types.PublishRequest
+}
+
+func (e *OpenPublishRequestError) Error() string {
+	return fmt.Sprintf("draft has an open publish request (#%d)", e.Request.PRNumber)
+}
+
+// RequestPublish, kategorinin taslak değişiklikleri için bir Pull Request açar ve onay bekleyen bir yayınlama talebi oluşturur.
+// Talep anındaki taslak commit'i saklanır; onaydan sonra taslağa eklenen değişiklikler bu talep ile yayınlanamaz.
+func (h *Handler) RequestPublish(c *gin.Context) {
+	var req struct {
+		Message string `json:"message" binding:"max=2000"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	contentType := ContentType(c.Param("category"))
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	openRequest, err := h.contentRepository.GetOpenPublishRequest(ctx, string(contentType), category.DraftBranch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not check publish requests",
+			"details": err.Error(),
+		})
+		return
+	}
+	if openRequest != nil {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "There is already an open publish request for this draft",
+			"request": openRequest,
+		})
+		return
+	}
+
+	status := h.getCategoryDraftStatus(category)
+	if !status.CanPublish {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "No draft changes to publish",
+			"details": status.Message,
+		})
+		return
+	}
+
+	if !h.checkDraftContentResponse(c, category) {
+		return
+	}
+
+	headSHA, err := h.repo(category).GetBranchHead(category.DraftBranch)
+	if err != nil || headSHA == "" {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not resolve draft branch head"})
+		return
+	}
+
+	userID := currentUserID(c)
+	title := fmt.Sprintf("feat(%s): publish changes from %s", contentType, category.DraftBranch)
+	body := fmt.Sprintf("Publish request for **%s** (%d changed files), awaiting approval.", category.Name, status.TotalFiles)
+	if req.Message != "" {
+		body += "\n\n" + req.Message
+	}
+
+	prNumber, prURL, err := h.repo(category).CreatePullRequest(category.BaseBranch, category.DraftBranch, title, body)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error":   "Could not create pull request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	input := types.CreatePublishRequestInput{
+		Category:    string(contentType),
+		Branch:      category.DraftBranch,
+		HeadSHA:     headSHA,
+		PRNumber:    prNumber,
+		PRURL:       prURL,
+		RequestedBy: userID,
+	}
+	if req.Message != "" {
+		input.Message = &req.Message
+	}
+
+	request, err := h.contentRepository.CreatePublishRequest(ctx, input)
+	if err != nil {
+		// Kayıt oluşturulamazsa açılan PR sahipsiz kalmasın
+		if closeErr := h.repo(category).ClosePullRequest(prNumber); closeErr != nil {
+			log.Printf("[GITHUB] Yayınlama talebi için açılan PR kapatılamadı (#%d): %v", prNumber, closeErr)
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not create publish request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"request": request,
+	})
+}
+
+// ListPublishRequests yayınlama taleplerini listeler. "status" ve "category" parametreleriyle filtrelenebilir.
+func (h *Handler) ListPublishRequests(c *gin.Context) {
+	status := c.Query("status")
+	switch types.PublishRequestStatus(status) {
+	case "", types.PublishRequestStatusPending, types.PublishRequestStatusApproved, types.PublishRequestStatusPublished,
+		types.PublishRequestStatusFailed, types.PublishRequestStatusRejected:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid publish request status"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 500 {
+		limit = 50
+	}
+
+	requests, err := h.contentRepository.ListPublishRequests(c.Request.Context(), status, c.Query("category"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not list publish requests",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"requests": requests,
+	})
+}
+
+// GetPublishRequest tek bir yayınlama talebini yorumlarıyla birlikte döndürür
+func (h *Handler) GetPublishRequest(c *gin.Context) {
+	request, ok := h.loadPublishRequest(c)
+	if !ok {
+		return
+	}
+
+	comments, err := h.contentRepository.GetPublishRequestComments(c.Request.Context(), request.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not get comments",
+			"details": err.Error(),
+		})
+		return
+	}
+	request.Comments = comments
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"request": request,
+	})
+}
+
+// ApprovePublishRequest bekleyen talebi onaylar ve Pull Request'i birleştirir.
+// Talebi açan kullanıcı kendi talebini onaylayamaz. Taslak talepten sonra değiştiyse birleştirme yapılmaz.
+func (h *Handler) ApprovePublishRequest(c *gin.Context) {
+	request, note, ok := h.beginPublishReview(c)
+	if !ok {
+		return
+	}
+
+	category, exists := h.category(ContentType(request.Category))
+	if !exists {
+		c.JSON(http.StatusConflict, gin.H{"error": "Content category of this publish request no longer exists"})
+		return
+	}
+	// Kişisel taslak modunda talep, onu açan kullanıcının taslak branch'ine aittir
+	category.DraftBranch = request.Branch
+
+	// Geçersiz içerik onaylanamaz; talep, taslak düzeltilene kadar beklemede kalır
+	if !h.checkDraftContentResponse(c, category) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	reviewerID := currentUserID(c)
+	if err := h.contentRepository.ReviewPublishRequest(ctx, request.ID, types.PublishRequestStatusApproved, reviewerID, note); err != nil {
+		h.writeReviewError(c, err)
+		return
+	}
+
+	files := h.draftPublishedFiles(category)
+
+	mergeMessage := fmt.Sprintf("feat(%s): publish changes from %s", request.Category, request.Branch)
+	result, err := h.repo(category).MergePullRequest(request.PRNumber, request.HeadSHA, mergeMessage)
+	if err != nil {
+		h.completePublishRequest(request.ID, types.PublishRequestStatusFailed, err.Error())
+
+		// Birleştirilemeyen talebin PR'ı açık bırakılmaz; düzeltmeden sonra yeni bir talep açılır
+		if closeErr := h.repo(category).ClosePullRequest(request.PRNumber); closeErr != nil {
+			log.Printf("[GITHUB] Birleştirilemeyen talebin PR'ı kapatılamadı (#%d): %v", request.PRNumber, closeErr)
+		}
+
+		if errors.Is(err, GithubService.ErrBranchHeadMismatch) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "Draft has changed since the publish request was created, a new request is required",
+			})
+			return
+		}
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error":   "Failed to publish, the draft may conflict with " + category.BaseBranch,
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.completePublishRequest(request.ID, types.PublishRequestStatusPublished, result.Message)
+
+	message := ""
+	if request.Message != nil {
+		message = *request.Message
+	}
+	h.recordPublish(category, types.PublishSourceApproval, request.PRNumber, result, files, message, &reviewerID)
+
+	// Draft branch'ı sil
+	if err := h.repo(category).DeleteBranch(request.Branch); err != nil {
+		log.Printf("[GITHUB] Yayınlanan taslak branch silinemedi (%s): %v", request.Branch, err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  fmt.Sprintf("%s category published successfully", request.Category),
+		"report":   result.Message,
+		"mergeSha": result.SHA,
+		"category": request.Category,
+	})
+}
+
+// RejectPublishRequest bekleyen talebi reddeder ve Pull Request'i kapatır. Taslak branch korunur.
+func (h *Handler) RejectPublishRequest(c *gin.Context) {
+	request, note, ok := h.beginPublishReview(c)
+	if !ok {
+		return
+	}
+
+	reviewerID := currentUserID(c)
+	if err := h.contentRepository.ReviewPublishRequest(c.Request.Context(), request.ID, types.PublishRequestStatusRejected, reviewerID, note); err != nil {
+		h.writeReviewError(c, err)
+		return
+	}
+
+	// Kategori silinmiş veya devre dışı bırakılmışsa PR'ın reposu bilinmez; talep yine de reddedilir
+	category, exists := h.category(ContentType(request.Category))
+	if !exists {
+		log.Printf("[GITHUB] Reddedilen talebin kategorisi bulunamadı, PR kapatılmadı (#%d, %s)", request.PRNumber, request.Category)
+	} else {
+		repository := h.repo(category)
+		if note != nil {
+			if err := repository.CommentOnPullRequest(request.PRNumber, "Publish request rejected: "+*note); err != nil {
+				log.Printf("[GITHUB] Red notu PR'a yazılamadı (#%d): %v", request.PRNumber, err)
+			}
+		}
+		if err := repository.ClosePullRequest(request.PRNumber); err != nil {
+			log.Printf("[GITHUB] Reddedilen talebin PR'ı kapatılamadı (#%d): %v", request.PRNumber, err)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  "Publish request rejected",
+		"category": request.Category,
+	})
+}
+
+// AddPublishRequestComment yayınlama talebine yorum ekler
+func (h *Handler) AddPublishRequestComment(c *gin.Context) {
+	var req struct {
+		Body string `json:"body" binding:"required,max=5000"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Comment body is required"})
+		return
+	}
+
+	request, ok := h.loadPublishRequest(c)
+	if !ok {
+		return
+	}
+
+	comment, err := h.contentRepository.CreatePublishRequestComment(c.Request.Context(), request.ID, currentUserID(c), body)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not add comment",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"comment": comment,
+	})
+}
+
+// loadPublishRequest, URL'deki ID ile talebi getirir. Bulunamazsa uygun yanıtı yazar ve false döndürür.
+func (h *Handler) loadPublishRequest(c *gin.Context) (*types.PublishRequest, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid publish request ID"})
+		return nil, false
+	}
+
+	request, err := h.contentRepository.GetPublishRequestByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not get publish request",
+			"details": err.Error(),
+		})
+		return nil, false
+	}
+	if request == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Publish request not found"})
+		return nil, false
+	}
+
+	return request, true
+}
+
+// beginPublishReview, onay/red isteklerinde ortak kontrolleri yapar: talep bekliyor olmalı ve
+// inceleyen kullanıcı talebi açan kullanıcıdan farklı olmalıdır. İsteğe bağlı inceleme notunu da döndürür.
+func (h *Handler) beginPublishReview(c *gin.Context) (*types.PublishRequest, *string, bool) {
+	var req struct {
+		Note string `json:"note" binding:"max=2000"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return nil, nil, false
+	}
+
+	request, ok := h.loadPublishRequest(c)
+	if !ok {
+		return nil, nil, false
+	}
+
+	if request.Status != types.PublishRequestStatusPending {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":  "Publish request is not pending",
+			"status": request.Status,
+		})
+		return nil, nil, false
+	}
+
+	if request.RequestedBy == currentUserID(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You cannot review your own publish request"})
+		return nil, nil, false
+	}
+
+	var note *string
+	if trimmed := strings.TrimSpace(req.Note); trimmed != "" {
+		note = &trimmed
+	}
+	return request, note, true
+}
+
+func (h *Handler) writeReviewError(c *gin.Context, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Publish request has already been reviewed"})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error":   "Could not update publish request",
+		"details": err.Error(),
+	})
+}
+
+func (h *Handler) completePublishRequest(id uuid.UUID, status types.PublishRequestStatus, message string) {
+	if err := h.contentRepository.CompletePublishRequest(context.Background(), id, status, message); err != nil {
+		log.Printf("[GITHUB] Yayınlama talebinin sonucu kaydedilemedi (id: %s): %v", id, err)
+	}
+}
@@ -0,0 +1,294 @@
+package GithubHandler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// maxScheduleAhead, bir yayınlamanın en fazla ne kadar ileriye zamanlanabileceğidir.
+// Otomasyon servisi tek seferlik işleri yıl bilgisi olmadan zamanladığı için bir yıldan uzun olamaz.
+const maxScheduleAhead = 365 * 24 * time.Hour
+
+// SchedulePublish, kategorinin taslak değişikliklerini ileri bir tarihte yayınlanmak üzere zamanlar.
+// Zamanlama anındaki taslak commit'i saklanır; taslak sonradan değişirse yayınlama atlanır.
+// allowDraftChanges true gönderilirse yayınlama anındaki taslak olduğu gibi yayınlanır.
+func (h *Handler) SchedulePublish(c *gin.Context) {
+	var req struct {
+		PublishAt         string `json:"publishAt" binding:"required"`
+		Message           string `json:"message" binding:"max=2000"`
+		AllowDraftChanges bool   `json:"allowDraftChanges"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	publishAt, err := time.Parse(time.RFC3339, req.PublishAt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use ISO 8601."})
+		return
+	}
+	if !publishAt.After(time.Now()) || time.Until(publishAt) > maxScheduleAhead {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "publishAt must be in the future and within one year"})
+		return
+	}
+
+	contentType := ContentType(c.Param("category"))
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
+		return
+	}
+
+	// Onay modunda zamanlanmış yayın onay adımını atlayacağı için yalnızca onay yetkisi olanlar zamanlayabilir
+	if configs.GetGithubPublishApprovalRequired() && !hasPermission(c, types.CanApproveGithubPublish) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":               "Publishing requires approval",
+			"required_permission": types.CanApproveGithubPublish,
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+	openSchedule, err := h.contentRepository.GetOpenPublishSchedule(ctx, string(contentType), category.DraftBranch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not check publish schedules",
+			"details": err.Error(),
+		})
+		return
+	}
+	if openSchedule != nil {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":    "There is already a scheduled publish for this draft",
+			"schedule": openSchedule,
+		})
+		return
+	}
+
+	status := h.getCategoryDraftStatus(category)
+	if !status.CanPublish {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "No draft changes to publish",
+			"details": status.Message,
+		})
+		return
+	}
+
+	headSHA, err := h.repo(category).GetBranchHead(category.DraftBranch)
+	if err != nil || headSHA == "" {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not resolve draft branch head"})
+		return
+	}
+
+	input := types.CreatePublishScheduleInput{
+		Category:          string(contentType),
+		Branch:            category.DraftBranch,
+		HeadSHA:           headSHA,
+		PublishAt:         publishAt,
+		AllowDraftChanges: req.AllowDraftChanges,
+		CreatedBy:         currentUserID(c),
+	}
+	if req.Message != "" {
+		input.Message = &req.Message
+	}
+
+	schedule, err := h.contentRepository.CreatePublishSchedule(ctx, input)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not create publish schedule",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := h.registerPublishSchedule(*schedule); err != nil {
+		// Otomasyona eklenemeyen zamanlama bekleyen durumda kalmasın
+		h.contentRepository.CancelPublishSchedule(context.Background(), schedule.ID, input.CreatedBy)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not register publish schedule",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":  true,
+		"schedule": schedule,
+	})
+}
+
+// ListPublishSchedules zamanlanmış yayınları listeler. "status" ve "category" parametreleriyle filtrelenebilir.
+// Atlanan veya başarısız olan yayınların nedeni resultMessage alanında döner.
+func (h *Handler) ListPublishSchedules(c *gin.Context) {
+	status := c.Query("status")
+	switch types.PublishScheduleStatus(status) {
+	case "", types.PublishScheduleStatusScheduled, types.PublishScheduleStatusRunning, types.PublishScheduleStatusPublished,
+		types.PublishScheduleStatusSkipped, types.PublishScheduleStatusFailed, types.PublishScheduleStatusCancelled:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid publish schedule status"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 500 {
+		limit = 50
+	}
+
+	schedules, err := h.contentRepository.ListPublishSchedules(c.Request.Context(), status, c.Query("category"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not list publish schedules",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"schedules": schedules,
+	})
+}
+
+// CancelPublishSchedule bekleyen bir zamanlamayı iptal eder
+func (h *Handler) CancelPublishSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid publish schedule ID"})
+		return
+	}
+
+	err = h.contentRepository.CancelPublishSchedule(c.Request.Context(), id, currentUserID(c))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No pending publish schedule found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not cancel publish schedule",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.automationService.CancelSchedule(publishScheduleKey(id))
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Publish schedule cancelled",
+	})
+}
+
+// LoadPublishSchedules bekleyen zamanlamaları veritabanından okuyup otomasyon servisine yeniden kaydeder.
+// Otomasyon kayıtları bellekte tutulduğundan sunucu açılışında çağrılmalıdır.
+// Sunucu kapalıyken zamanı geçmiş yayınlar hemen çalıştırılır.
+func (h *Handler) LoadPublishSchedules(ctx context.Context) {
+	interrupted, err := h.contentRepository.FailInterruptedPublishSchedules(ctx, "Publish was interrupted by a server restart")
+	if err != nil {
+		log.Printf("[GITHUB] Yarıda kalan zamanlanmış yayınlar işaretlenemedi: %v", err)
+	} else if interrupted > 0 {
+		log.Printf("[GITHUB] %d yarıda kalan zamanlanmış yayın başarısız olarak işaretlendi", interrupted)
+	}
+
+	schedules, err := h.contentRepository.GetScheduledPublishSchedules(ctx)
+	if err != nil {
+		log.Printf("[GITHUB] Zamanlanmış yayınlar yüklenemedi: %v", err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		if !schedule.PublishAt.After(time.Now()) {
+			go h.runPublishSchedule(schedule.ID)
+			continue
+		}
+		if err := h.registerPublishSchedule(schedule); err != nil {
+			log.Printf("[GITHUB] Zamanlanmış yayın kaydedilemedi (id: %s): %v", schedule.ID, err)
+		}
+	}
+}
+
+func (h *Handler) registerPublishSchedule(schedule types.PublishSchedule) error {
+	id := schedule.ID
+	return h.automationService.Schedule(publishScheduleKey(id), schedule.PublishAt.Local(), func() {
+		h.runPublishSchedule(id)
+	})
+}
+
+// runPublishSchedule zamanı gelen yayınlamayı çalıştırır. Sonuç (yayınlandı, atlandı, başarısız) kayda yazılır.
+func (h *Handler) runPublishSchedule(id uuid.UUID) {
+	ctx := context.Background()
+
+	// Zamanlama iptal edilmişse veya başka bir çalıştırma tarafından alınmışsa hiçbir şey yapılmaz
+	if err := h.contentRepository.StartPublishSchedule(ctx, id); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("[GITHUB] Zamanlanmış yayın başlatılamadı (id: %s): %v", id, err)
+		}
+		return
+	}
+
+	schedule, err := h.contentRepository.GetPublishScheduleByID(ctx, id)
+	if err != nil || schedule == nil {
+		log.Printf("[GITHUB] Zamanlanmış yayın okunamadı (id: %s): %v", id, err)
+		return
+	}
+
+	status, message := h.executePublishSchedule(*schedule)
+	log.Printf("[GITHUB] Zamanlanmış yayın sonucu (id: %s, kategori: %s): %s - %s", id, schedule.Category, status, message)
+
+	if err := h.contentRepository.CompletePublishSchedule(ctx, id, status, message); err != nil {
+		log.Printf("[GITHUB] Zamanlanmış yayının sonucu kaydedilemedi (id: %s): %v", id, err)
+	}
+}
+
+func (h *Handler) executePublishSchedule(schedule types.PublishSchedule) (types.PublishScheduleStatus, string) {
+	category, exists := h.category(ContentType(schedule.Category))
+	if !exists {
+		return types.PublishScheduleStatusFailed, "Content category no longer exists"
+	}
+	// Kişisel taslak modunda zamanlama, onu oluşturan kullanıcının taslak branch'ine aittir
+	category.DraftBranch = schedule.Branch
+
+	headSHA, err := h.repo(category).GetBranchHead(category.DraftBranch)
+	if err != nil {
+		return types.PublishScheduleStatusFailed, "Could not resolve draft branch head: " + err.Error()
+	}
+	if headSHA == "" {
+		return types.PublishScheduleStatusSkipped, "Draft branch no longer exists"
+	}
+
+	expectedHeadSHA := schedule.HeadSHA
+	if headSHA != schedule.HeadSHA {
+		if !schedule.AllowDraftChanges {
+			return types.PublishScheduleStatusSkipped, fmt.Sprintf("Draft changed after scheduling (scheduled %s, current %s)", schedule.HeadSHA, headSHA)
+		}
+		expectedHeadSHA = headSHA
+	}
+
+	message := fmt.Sprintf("Scheduled publish for %s at %s.", category.Name, schedule.PublishAt.UTC().Format(time.RFC3339))
+	if schedule.Message != nil {
+		message += "\n\n" + *schedule.Message
+	}
+
+	result, err := h.publishDraft(category, expectedHeadSHA, message, types.PublishSourceSchedule, &schedule.CreatedBy)
+	if err != nil {
+		return types.PublishScheduleStatusFailed, err.Error()
+	}
+	return types.PublishScheduleStatusPublished, result.Message
+}
+
+func publishScheduleKey(id uuid.UUID) string {
+	return "github:publish-schedule:" + id.String()
+}
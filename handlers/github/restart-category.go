@@ -11,20 +11,19 @@ func (h *Handler) RestartCategory(c *gin.Context) {
 	categoryParam := c.Param("category")
 	contentType := ContentType(categoryParam)
 
-	category, exists := h.categories[contentType]
-	if !exists {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
 		return
 	}
 
 	draftBranch := category.DraftBranch
-	exists, err := h.repository.BranchExists(draftBranch)
+	exists, err := h.repo(category).BranchExists(draftBranch)
 	if err != nil || !exists {
 		c.JSON(http.StatusNotFound, gin.H{"error": "No draft branch found to delete"})
 		return
 	}
 
-	if err := h.repository.DeleteBranch(draftBranch); err != nil {
+	if err := h.repo(category).DeleteBranch(draftBranch); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Could not delete draft branch",
 			"details": err.Error(),
@@ -0,0 +1,148 @@
+package GithubHandler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	GithubService "github.com/okanay/backend-template/services/github"
+)
+
+// maxBatchFiles, tek bir toplu kayıtta gönderilebilecek en fazla dosya sayısı
+const maxBatchFiles = 100
+
+type BatchFileError struct {
+	Path   string         `json:"path"`
+	Error  string         `json:"error"`
+	Issues []ContentIssue `json:"issues,omitempty"`
+}
+
+// SaveBatch, birden fazla dosyayı doğrulayıp taslak branch'e tek bir commit olarak yazar.
+// Dosyalardan biri bile doğrulamadan geçemezse hiçbir dosya kaydedilmez.
+// İstemci headSha gönderirse ve taslak branch o SHA'dan sonra güncellenmişse 409 döner.
+func (h *Handler) SaveBatch(c *gin.Context) {
+	var req struct {
+		Files []struct {
+			Path    string `json:"path" binding:"required"`
+			Content string `json:"content" binding:"required"`
+		} `json:"files" binding:"required,min=1,dive"`
+		Message string `json:"message"`
+		HeadSHA string `json:"headSha"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	contentType := ContentType(c.Param("category"))
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
+		return
+	}
+
+	if len(req.Files) > maxBatchFiles {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("A batch can contain at most %d files", maxBatchFiles),
+		})
+		return
+	}
+
+	// Tüm dosyalar commit'ten önce doğrulanır; hatalar dosya bazında toplanır
+	var fileErrors []BatchFileError
+	seen := make(map[string]bool, len(req.Files))
+	changes := make([]GithubService.FileChange, 0, len(req.Files))
+	for _, file := range req.Files {
+		if seen[file.Path] {
+			fileErrors = append(fileErrors, BatchFileError{Path: file.Path, Error: "Duplicate path in batch"})
+			continue
+		}
+		seen[file.Path] = true
+
+		if err := h.checkContentFile(category, file.Path, file.Content); err != nil {
+			fileError := BatchFileError{Path: file.Path, Error: err.Error()}
+			var validationErr *ContentValidationError
+			if errors.As(err, &validationErr) {
+				fileError.Issues = validationErr.Issues
+			}
+			fileErrors = append(fileErrors, fileError)
+			continue
+		}
+		changes = append(changes, GithubService.FileChange{Path: file.Path, Content: file.Content})
+	}
+
+	if len(fileErrors) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "Content validation failed",
+			"errors": fileErrors,
+		})
+		return
+	}
+
+	// Draft branch'ı oluştur (yoksa). Yeni branch main'in son commit'inden açıldığı için
+	// istemcinin main'den aldığı headSha ile karşılaştırma geçerli kalır.
+	draftBranch := category.DraftBranch
+	if err := h.ensureDraftBranch(category); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not create draft branch",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	commitMessage := req.Message
+	if commitMessage == "" {
+		commitMessage = fmt.Sprintf("feat(%s): update %d files", contentType, len(changes))
+	}
+
+	commitSHA, ok := h.commitChanges(c, category, draftBranch, req.HeadSHA, changes, commitMessage)
+	if !ok {
+		return
+	}
+
+	// İçerikte kullanılan dosyaların referans indeksini güncelle
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		h.updateFileReferences(contentType, draftBranch, change.Path, change.Content)
+		paths = append(paths, change.Path)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":   fmt.Sprintf("%d files saved to %s in a single commit", len(changes), draftBranch),
+		"sha":      commitSHA,
+		"branch":   draftBranch,
+		"category": contentType,
+		"paths":    paths,
+		"warnings": h.localeParityWarnings(category, commitSHA, paths),
+		"success":  true,
+	})
+}
+
+// checkContentFile, bir dosyanın kategorinin yol, uzantı, boyut ve içerik kurallarına uyduğunu doğrular
+func (h *Handler) checkContentFile(category ContentCategory, path, content string) error {
+	if err := h.checkContentPath(category, path); err != nil {
+		return err
+	}
+
+	if category.MaxSize > 0 && int64(len(content)) > category.MaxSize {
+		return fmt.Errorf("content size exceeds maximum allowed size of %d bytes", category.MaxSize)
+	}
+
+	return h.validateContent(content, category, path)
+}
+
+// ensureDraftBranch, kategorinin taslak branch'i yoksa main'den oluşturur
+func (h *Handler) ensureDraftBranch(category ContentCategory) error {
+	exists, err := h.repo(category).BranchExists(category.DraftBranch)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	return h.repo(category).CreateBranch(category.BaseBranch, category.DraftBranch)
+}
@@ -1,6 +1,7 @@
 package GithubHandler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"path/filepath"
@@ -27,9 +28,8 @@ func (h *Handler) SaveContent(c *gin.Context) {
 	}
 
 	contentType := ContentType(req.Category)
-	category, exists := h.categories[contentType]
-	if !exists {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
 		return
 	}
 
@@ -53,17 +53,22 @@ func (h *Handler) SaveContent(c *gin.Context) {
 	}
 
 	// Content validation (kategoriye göre)
-	if err := h.validateContent(req.Content, contentType, req.Path); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+	if err := h.validateContent(req.Content, category, req.Path); err != nil {
+		response := gin.H{
 			"error":   "Content validation failed",
 			"details": err.Error(),
-		})
+		}
+		var validationErr *ContentValidationError
+		if errors.As(err, &validationErr) {
+			response["issues"] = validationErr.Issues
+		}
+		c.JSON(http.StatusBadRequest, response)
 		return
 	}
 
 	// Draft branch'ı oluştur (yoksa)
 	draftBranch := category.DraftBranch
-	exists, err := h.repository.BranchExists(draftBranch)
+	exists, err := h.repo(category).BranchExists(draftBranch)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Could not check for draft branch",
@@ -72,7 +77,7 @@ func (h *Handler) SaveContent(c *gin.Context) {
 		return
 	}
 	if !exists {
-		if err := h.repository.CreateBranch(h.mainBranch, draftBranch); err != nil {
+		if err := h.repo(category).CreateBranch(category.BaseBranch, draftBranch); err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error":   "Could not create draft branch",
 				"details": err.Error(),
@@ -89,7 +94,7 @@ func (h *Handler) SaveContent(c *gin.Context) {
 	}
 
 	// Content'i commit et
-	err = h.repository.CommitFile(draftBranch, req.Path, req.Content, req.SHA, commitMessage)
+	err = h.repo(category).CommitFile(draftBranch, req.Path, req.Content, req.SHA, commitMessage)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Could not commit content",
@@ -98,8 +103,11 @@ func (h *Handler) SaveContent(c *gin.Context) {
 		return
 	}
 
+	// İçerikte kullanılan dosyaların referans indeksini güncelle
+	h.updateFileReferences(contentType, draftBranch, req.Path, req.Content)
+
 	// Yeni SHA'yı al
-	_, newSHA, err := h.repository.GetFileContent(draftBranch, req.Path)
+	_, newSHA, err := h.repo(category).GetFileContent(draftBranch, req.Path)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Could not get new SHA after commit",
@@ -114,6 +122,7 @@ func (h *Handler) SaveContent(c *gin.Context) {
 		"branch":   draftBranch,
 		"category": req.Category,
 		"path":     req.Path,
+		"warnings": h.localeParityWarnings(category, draftBranch, []string{req.Path}),
 		"success":  true,
 	})
 }
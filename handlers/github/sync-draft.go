@@ -0,0 +1,77 @@
+package GithubHandler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	GithubService "github.com/okanay/backend-template/services/github"
+)
+
+// SyncDraft, temel branch'teki (ör. main) yeni commit'leri kategorinin taslak branch'ine birleştirir.
+// Çakışma varsa taslak değiştirilmez ve çakışma ihtimali olan dosyalar tek tek döndürülür;
+// bu dosyalar taslakta düzenlenerek veya temel branch'teki haline döndürülerek çözülmelidir.
+func (h *Handler) SyncDraft(c *gin.Context) {
+	contentType := ContentType(c.Param("category"))
+	category, ok := h.requestCategory(c, contentType)
+	if !ok {
+		return
+	}
+
+	draftBranch := category.DraftBranch
+	exists, err := h.repo(category).BranchExists(draftBranch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not check for draft branch",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No draft branch found to sync"})
+		return
+	}
+
+	message := fmt.Sprintf("chore(%s): sync %s with %s", contentType, draftBranch, category.BaseBranch)
+	mergeSHA, err := h.repo(category).MergeBranch(draftBranch, category.BaseBranch, message)
+	if err != nil {
+		if errors.Is(err, GithubService.ErrMergeConflict) {
+			response := gin.H{"error": "Draft conflicts with " + category.BaseBranch + ", resolve the conflicting files and try again"}
+
+			draftChanges, err := h.repo(category).GetBranchChanges(category.BaseBranch, draftBranch)
+			if err == nil {
+				conflicts, err := h.findConflictingFiles(category, draftChanges)
+				if err == nil {
+					response["conflictingFiles"] = conflicts
+				}
+			}
+
+			c.JSON(http.StatusConflict, response)
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Could not sync draft branch",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if mergeSHA == "" {
+		c.JSON(http.StatusOK, gin.H{
+			"status":   fmt.Sprintf("%s is already up to date with %s", draftBranch, category.BaseBranch),
+			"branch":   draftBranch,
+			"category": contentType,
+			"success":  true,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":   fmt.Sprintf("%s synced with %s", draftBranch, category.BaseBranch),
+		"sha":      mergeSHA,
+		"branch":   draftBranch,
+		"category": contentType,
+		"success":  true,
+	})
+}
@@ -0,0 +1,110 @@
+package GithubHandler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// userDraftBranch, kullanıcının kategorideki kişisel taslak branch'idir (ör. "i18n-draft/<userId>")
+func userDraftBranch(category ContentCategory, userID uuid.UUID) string {
+	return category.DraftBranch + "/" + userID.String()
+}
+
+// requestCategory, kategoriyi isteği yapan kullanıcının taslağına göre döndürür. Kişisel taslak modunda DraftBranch
+// kullanıcının branch'i olur; böylece durum, fark, kaydetme ve yayınlama yalnızca kullanıcının taslağını kapsar.
+// Adminler "userId" parametresiyle başka bir kullanıcının taslağını seçebilir.
+// Kategori veya kullanıcı geçersizse uygun yanıtı yazar ve false döndürür.
+func (h *Handler) requestCategory(c *gin.Context, contentType ContentType) (ContentCategory, bool) {
+	category, exists := h.category(contentType)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+		return ContentCategory{}, false
+	}
+	if !category.UserDrafts {
+		return category, true
+	}
+
+	userID := currentUserID(c)
+	if param := c.Query("userId"); param != "" {
+		parsed, err := uuid.Parse(param)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid userId"})
+			return ContentCategory{}, false
+		}
+		if parsed != userID && !isAdmin(c) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only admins can access other users' drafts"})
+			return ContentCategory{}, false
+		}
+		userID = parsed
+	}
+
+	category.DraftBranch = userDraftBranch(category, userID)
+	return category, true
+}
+
+// draftBranches, kategorinin açık taslak branch'lerini döndürür: paylaşılan taslak ve kişisel taslaklar.
+// Mod değiştirildikten sonra kalan taslaklar da dahil edilir.
+func (h *Handler) draftBranches(category ContentCategory) ([]string, error) {
+	repository := h.repo(category)
+
+	var branches []string
+	exists, err := repository.BranchExists(category.DraftBranch)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		branches = append(branches, category.DraftBranch)
+	}
+
+	userBranches, err := repository.ListBranches(category.DraftBranch + "/")
+	if err != nil {
+		return nil, err
+	}
+	return append(branches, userBranches...), nil
+}
+
+// ListDrafts, kategorideki tüm açık taslakları durumlarıyla birlikte döndürür. Yalnızca adminler kullanabilir;
+// kişisel taslaklardan biri üzerinde işlem yapmak için diğer rotalara "userId" parametresi eklenir.
+func (h *Handler) ListDrafts(c *gin.Context) {
+	category, exists := h.category(ContentType(c.Param("category")))
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid content category"})
+		return
+	}
+
+	branches, err := h.draftBranches(category)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error":   "Could not list draft branches",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	drafts := make([]OpenDraft, 0, len(branches))
+	for _, branch := range branches {
+		draft := OpenDraft{}
+		if suffix, isUserDraft := strings.CutPrefix(branch, category.DraftBranch+"/"); isUserDraft {
+			userID, err := uuid.Parse(suffix)
+			if err != nil {
+				continue // Kişisel taslak adı kuralına uymayan branch'ler bu kategoriye ait değildir
+			}
+			draft.UserID = &userID
+		}
+
+		scoped := category
+		scoped.DraftBranch = branch
+		draft.Status = h.getCategoryDraftStatus(scoped)
+		drafts = append(drafts, draft)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"category":   category.Type,
+		"userDrafts": category.UserDrafts,
+		"drafts":     drafts,
+	})
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"os"
@@ -16,16 +17,19 @@ import (
 	GithubHandler "github.com/okanay/backend-template/handlers/github"
 	StaticRoutesHandler "github.com/okanay/backend-template/handlers/static-route-handlers"
 	AuthRepository "github.com/okanay/backend-template/repositories/auth"
+	ContentRepository "github.com/okanay/backend-template/repositories/content"
 	FileRepository "github.com/okanay/backend-template/repositories/file"
 	TokenRepository "github.com/okanay/backend-template/repositories/token"
 
 	"github.com/okanay/backend-template/middlewares"
+	"github.com/okanay/backend-template/types"
 
 	AutomationService "github.com/okanay/backend-template/services/automation"
 	cache "github.com/okanay/backend-template/services/cache"
 	GithubService "github.com/okanay/backend-template/services/github"
 	GothService "github.com/okanay/backend-template/services/goth"
-	R2Service "github.com/okanay/backend-template/services/r2"
+	"github.com/okanay/backend-template/services/scanner"
+	"github.com/okanay/backend-template/services/storage"
 	ValidationService "github.com/okanay/backend-template/services/validation"
 )
 
@@ -44,6 +48,7 @@ func main() {
 	userRepo := AuthRepository.NewRepository(db)
 	tokenRepo := TokenRepository.NewRepository(db)
 	fileRepo := FileRepository.NewRepository(db)
+	contentRepo := ContentRepository.NewRepository(db)
 
 	// Services Initialization
 	AutomationService := AutomationService.NewService()
@@ -58,22 +63,16 @@ func main() {
 		os.Getenv("GITHUB_OWNER"),
 		os.Getenv("GITHUB_REPOSITORY_NAME"),
 		os.Getenv("GITHUB_TOKEN"),
+		CacheService,
 	)
-	r2Service := R2Service.NewService(
-		os.Getenv("R2_ACCOUNT_ID"),
-		os.Getenv("R2_ACCESS_KEY_ID"),
-		os.Getenv("R2_ACCESS_KEY_SECRET"),
-		os.Getenv("R2_BUCKET_NAME"),
-		os.Getenv("R2_FOLDER_NAME"),
-		os.Getenv("R2_PUBLIC_URL_BASE"),
-		os.Getenv("R2_ENDPOINT"),
-	)
+	storageService := storage.NewStorageService()
+	scannerService := scanner.NewScannerService()
 
 	// Handlers (İstekleri İşleyen Katman)
 	staticHandler := StaticRoutesHandler.NewHandler(ValidationService)
 	authHandler := AuthHandler.NewHandler(gothService, userRepo, tokenRepo, ValidationService)
-	fileHandler := FileHandler.NewHandler(fileRepo, r2Service, ValidationService)
-	githubHandler := GithubHandler.NewHandler(githubService, ValidationService)
+	fileHandler := FileHandler.NewHandler(fileRepo, storageService, scannerService, ValidationService)
+	githubHandler := GithubHandler.NewHandler(githubService, contentRepo, fileRepo, AutomationService, ValidationService)
 
 	// --- AUTOMATION ---
 
@@ -83,6 +82,46 @@ func main() {
 		}
 	})
 
+	AutomationService.Add("files:abort-expired-multipart", "@every 1h", func() {
+		fileHandler.CleanupExpiredMultipartUploads(context.Background())
+	})
+
+	AutomationService.Add("files:scan-quarantined", "@every 10m", func() {
+		fileHandler.ScanQuarantinedFiles(context.Background())
+	})
+
+	AutomationService.Add("files:release-expired-reservations", "@every 1h", func() {
+		fileHandler.ReleaseExpiredReservations(context.Background())
+	})
+
+	AutomationService.Add("files:expire-retention", "@every 6h", func() {
+		fileHandler.TrashExpiredRetentionFiles(context.Background())
+	})
+
+	AutomationService.Add("files:purge-trash", "@every 6h", func() {
+		fileHandler.PurgeExpiredTrash(context.Background())
+	})
+
+	AutomationService.Add("files:rebuild-references", "@every 6h", func() {
+		fileHandler.RebuildAvatarReferences(context.Background())
+		githubHandler.RebuildFileReferences(context.Background())
+	})
+
+	// Birden fazla sunucu çalışırken diğer sunucularda yapılan kategori değişiklikleri bu yolla uygulanır
+	AutomationService.Add("github:reload-categories", "@every 1m", func() {
+		if err := githubHandler.ReloadCategories(context.Background()); err != nil {
+			log.Printf("[GITHUB] İçerik kategorileri yeniden yüklenemedi: %v", err)
+		}
+	})
+
+	// İçerik kategorileri veritabanında tutulur; zamanlanmış yayınlar ve referans taraması kategorilere ihtiyaç duyar
+	if err := githubHandler.ReloadCategories(context.Background()); err != nil {
+		log.Printf("[GITHUB] İçerik kategorileri yüklenemedi: %v", err)
+	}
+
+	// Otomasyon kayıtları bellekte tutulduğundan bekleyen zamanlanmış yayınlar veritabanından yüklenir
+	githubHandler.LoadPublishSchedules(context.Background())
+
 	// --- ROTALAR ---
 
 	// Global ve 404 Rotaları
@@ -90,6 +129,12 @@ func main() {
 	router.NoRoute(staticHandler.NotFound)
 	router.POST("/test-validate", staticHandler.TestValidate)
 
+	// Yerel storage kullanılıyorsa, presigned URL'leri sunucunun kendisi karşılar
+	if localStorage, ok := storageService.(*storage.LocalStorage); ok {
+		router.PUT("/storage/*key", localStorage.HandleUpload)
+		router.GET("/storage/*key", localStorage.HandleDownload)
+	}
+
 	// API Versiyonlama Grubu (v1)
 	v1 := router.Group("/v1")
 	{
@@ -123,18 +168,60 @@ func main() {
 
 			// Dosya Yönetimi
 			protected.GET("/files", fileHandler.GetFilesByCategory)
+			protected.GET("/files/categories", fileHandler.GetFileCategories)
+			protected.GET("/files/usage", fileHandler.GetStorageUsage)
+			protected.GET("/files/usage/breakdown", middlewares.RequireRole(types.RoleAdmin), fileHandler.GetStorageUsageBreakdown)
 			protected.DELETE("/files/:id", fileHandler.DeleteFile)
+			protected.GET("/files/:id/download", fileHandler.DownloadFile)
+			protected.GET("/files/:id/references", fileHandler.GetFileReferences)
+			protected.GET("/files/trash", fileHandler.GetTrashedFiles)
+			protected.POST("/files/:id/restore", fileHandler.RestoreFile)
+			protected.DELETE("/files/:id/permanent", middlewares.RequireRole(types.RoleAdmin), fileHandler.PermanentlyDeleteFile)
+			protected.GET("/files/scan-events", middlewares.RequireRole(types.RoleAdmin), fileHandler.GetFileScanEvents)
+			protected.POST("/files/batch/delete", fileHandler.BatchDeleteFiles)
+			protected.POST("/files/batch/move", fileHandler.BatchMoveFiles)
+			protected.POST("/files/batch/tags", fileHandler.BatchTagFiles)
 			protected.POST("/files/presigned-url", fileHandler.CreatePresignedURL)
 			protected.POST("/files/confirm-upload", fileHandler.ConfirmUpload)
+			protected.POST("/files/upload", fileHandler.UploadFile)
+			protected.POST("/files/multipart/initiate", fileHandler.InitiateMultipartUpload)
+			protected.POST("/files/multipart/:id/parts", fileHandler.RefreshMultipartParts)
+			protected.POST("/files/multipart/:id/complete", fileHandler.CompleteMultipartUpload)
+			protected.DELETE("/files/multipart/:id", fileHandler.AbortMultipartUpload)
 
 			// İçerik Yönetimi (GitHub)
 			content := protected.Group("/github")
 			{
 				content.GET("/categories", githubHandler.GetCategories)
+				content.GET("/health", githubHandler.GetHealth)
+				content.GET("/categories/all", middlewares.RequireRole(types.RoleAdmin), githubHandler.ListAllCategories)
+				content.POST("/categories", middlewares.RequireRole(types.RoleAdmin), githubHandler.CreateCategory)
+				content.POST("/categories/reload", middlewares.RequireRole(types.RoleAdmin), githubHandler.ReloadContentCategories)
+				content.PUT("/categories/:type", middlewares.RequireRole(types.RoleAdmin), githubHandler.UpdateCategory)
+				content.DELETE("/categories/:type", middlewares.RequireRole(types.RoleAdmin), githubHandler.DeleteCategory)
+				content.GET("/publish-requests", githubHandler.ListPublishRequests)
+				content.GET("/publish-requests/:id", githubHandler.GetPublishRequest)
+				content.POST("/publish-requests/:id/approve", githubHandler.ApprovePublishRequest)
+				content.POST("/publish-requests/:id/reject", githubHandler.RejectPublishRequest)
+				content.POST("/publish-requests/:id/comments", githubHandler.AddPublishRequestComment)
+				content.GET("/publish-schedules", githubHandler.ListPublishSchedules)
+				content.DELETE("/publish-schedules/:id", githubHandler.CancelPublishSchedule)
 				content.GET("/:category", githubHandler.GetContent)
+				content.GET("/:category/tree", githubHandler.GetTree)
+				content.GET("/:category/bundle", githubHandler.GetBundle)
 				content.POST("/:category/save", githubHandler.SaveContent)
+				content.POST("/:category/save-batch", githubHandler.SaveBatch)
+				content.POST("/:category/delete", githubHandler.DeleteContent)
+				content.POST("/:category/move", githubHandler.MoveContent)
 				content.GET("/:category/draft-status", githubHandler.GetDraftStatus)
+				content.GET("/:category/drafts", middlewares.RequireRole(types.RoleAdmin), githubHandler.ListDrafts)
+				content.GET("/:category/diff", githubHandler.GetDiff)
+				content.POST("/:category/sync", githubHandler.SyncDraft)
 				content.POST("/:category/publish", githubHandler.PublishCategory)
+				content.POST("/:category/publish-requests", githubHandler.RequestPublish)
+				content.POST("/:category/publish-schedule", githubHandler.SchedulePublish)
+				content.GET("/:category/history", githubHandler.GetPublishHistory)
+				content.POST("/:category/rollback/:publishId", githubHandler.RollbackPublish)
 				content.DELETE("/:category/restart", githubHandler.RestartCategory)
 			}
 		}
@@ -17,17 +17,52 @@ var PermissionMap = map[string]types.Permission{
 
 	// File Routes
 	"GET:/v1/files":                 types.CanListFiles,
+	"GET:/v1/files/categories":      types.CanListFiles,
 	"DELETE:/v1/files/:id":          types.CanDeleteFile,
+	"GET:/v1/files/:id/download":    types.CanDownloadFile,
+	"GET:/v1/files/:id/references":  types.CanListFiles,
+	"GET:/v1/files/trash":           types.CanViewTrash,
+	"POST:/v1/files/:id/restore":    types.CanRestoreFile,
+	"POST:/v1/files/batch/delete":   types.CanDeleteFile,
+	"POST:/v1/files/batch/move":     types.CanMoveFile,
+	"POST:/v1/files/batch/tags":     types.CanTagFile,
 	"POST:/v1/files/presigned-url":  types.CanGetPresignedURL,
+	"POST:/v1/files/upload":         types.CanUploadFile,
+	"GET:/v1/files/usage":           types.CanGetPresignedURL,
 	"POST:/v1/files/confirm-upload": types.CanConfirmUpload,
 
+	"POST:/v1/files/multipart/initiate":     types.CanGetPresignedURL,
+	"POST:/v1/files/multipart/:id/parts":    types.CanGetPresignedURL,
+	"POST:/v1/files/multipart/:id/complete": types.CanConfirmUpload,
+	"DELETE:/v1/files/multipart/:id":        types.CanGetPresignedURL,
+
 	// Github Content Routes
 	"GET:/v1/github/categories":             types.CanViewGithubCategories,
+	"GET:/v1/github/health":                 types.CanViewGithubDraftStatus,
 	"GET:/v1/github/:category":              types.CanGetGithubContent,
+	"GET:/v1/github/:category/tree":         types.CanGetGithubContent,
+	"GET:/v1/github/:category/bundle":       types.CanGetGithubContent,
 	"POST:/v1/github/:category/save":        types.CanSaveGithubContent,
+	"POST:/v1/github/:category/save-batch":  types.CanSaveGithubContent,
+	"POST:/v1/github/:category/delete":      types.CanSaveGithubContent,
+	"POST:/v1/github/:category/move":        types.CanSaveGithubContent,
 	"GET:/v1/github/:category/draft-status": types.CanViewGithubDraftStatus,
+	"GET:/v1/github/:category/diff":         types.CanViewGithubDraftStatus,
+	"POST:/v1/github/:category/sync":        types.CanSaveGithubContent,
 	"POST:/v1/github/:category/publish":     types.CanPublishGithubContent,
 	"DELETE:/v1/github/:category/restart":   types.CanRestartGithubCategory,
+
+	"POST:/v1/github/:category/publish-requests":    types.CanPublishGithubContent,
+	"GET:/v1/github/publish-requests":               types.CanViewGithubDraftStatus,
+	"GET:/v1/github/publish-requests/:id":           types.CanViewGithubDraftStatus,
+	"POST:/v1/github/publish-requests/:id/comments": types.CanViewGithubDraftStatus,
+	"POST:/v1/github/publish-requests/:id/approve":  types.CanApproveGithubPublish,
+	"POST:/v1/github/publish-requests/:id/reject":   types.CanApproveGithubPublish,
+	"POST:/v1/github/:category/publish-schedule":    types.CanPublishGithubContent,
+	"GET:/v1/github/publish-schedules":              types.CanViewGithubDraftStatus,
+	"DELETE:/v1/github/publish-schedules/:id":       types.CanPublishGithubContent,
+	"GET:/v1/github/:category/history":              types.CanViewGithubDraftStatus,
+	"POST:/v1/github/:category/rollback/:publishId": types.CanPublishGithubContent,
 }
 
 func PermissionMiddleware(cs cache.CacheService, ar *AuthRepository.Repository) gin.HandlerFunc {
@@ -78,6 +113,9 @@ func PermissionMiddleware(cs cache.CacheService, ar *AuthRepository.Repository)
 			return
 		}
 
+		// Handler'lar kaynak bazlı ek kontroller (örn. dosya kategorisi yetkisi) için izinleri kullanabilir.
+		c.Set("user_permissions", userPermissions)
+
 		// 5. Kullanıcının sahip olduğu izinler arasında gerekli olan var mı diye kontrol et.
 		if slices.Contains(userPermissions, requiredPermission) {
 			c.Next() // İzin var, devam et.
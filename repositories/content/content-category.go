@@ -0,0 +1,147 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/okanay/backend-template/types"
+)
+
+const contentCategoryColumns = `
+	type, name, description, repo_owner, repo_name, base_branch, path, extensions, max_size,
+	validator, draft_branch, schema_path, user_drafts, enabled, created_at, updated_at
+`
+
+// ListContentCategories tüm içerik kategorilerini türe göre sıralı getirir
+func (r *Repository) ListContentCategories(ctx context.Context) ([]types.ContentCategory, error) {
+	query := `SELECT ` + contentCategoryColumns + ` FROM github_content_categories ORDER BY type`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := []types.ContentCategory{}
+	for rows.Next() {
+		category, err := scanContentCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, *category)
+	}
+	return categories, rows.Err()
+}
+
+// GetContentCategory tek bir kategoriyi getirir. Kayıt yoksa nil döner.
+func (r *Repository) GetContentCategory(ctx context.Context, categoryType string) (*types.ContentCategory, error) {
+	query := `SELECT ` + contentCategoryColumns + ` FROM github_content_categories WHERE type = $1`
+
+	category, err := scanContentCategory(r.db.QueryRowContext(ctx, query, categoryType))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+// CreateContentCategory yeni bir içerik kategorisi oluşturur
+func (r *Repository) CreateContentCategory(ctx context.Context, input types.ContentCategoryInput) (*types.ContentCategory, error) {
+	query := `
+		INSERT INTO github_content_categories (
+			type, name, description, repo_owner, repo_name, base_branch, path, extensions,
+			max_size, validator, draft_branch, schema_path, user_drafts, enabled
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+		RETURNING ` + contentCategoryColumns
+
+	return scanContentCategory(r.db.QueryRowContext(
+		ctx,
+		query,
+		input.Type,
+		input.Name,
+		input.Description,
+		input.RepoOwner,
+		input.RepoName,
+		input.BaseBranch,
+		input.Path,
+		pq.Array(input.Extensions),
+		input.MaxSize,
+		input.Validator,
+		input.DraftBranch,
+		input.SchemaPath,
+		input.UserDrafts,
+		input.Enabled,
+	))
+}
+
+// UpdateContentCategory kategorinin tüm ayarlarını günceller. Kategorinin adı (type) değiştirilemez.
+// Kategori yoksa sql.ErrNoRows döner.
+func (r *Repository) UpdateContentCategory(ctx context.Context, input types.ContentCategoryInput) (*types.ContentCategory, error) {
+	query := `
+		UPDATE github_content_categories
+		SET name = $2, description = $3, repo_owner = $4, repo_name = $5, base_branch = $6, path = $7,
+			extensions = $8, max_size = $9, validator = $10, draft_branch = $11, schema_path = $12, user_drafts = $13,
+			enabled = $14
+		WHERE type = $1
+		RETURNING ` + contentCategoryColumns
+
+	return scanContentCategory(r.db.QueryRowContext(
+		ctx,
+		query,
+		input.Type,
+		input.Name,
+		input.Description,
+		input.RepoOwner,
+		input.RepoName,
+		input.BaseBranch,
+		input.Path,
+		pq.Array(input.Extensions),
+		input.MaxSize,
+		input.Validator,
+		input.DraftBranch,
+		input.SchemaPath,
+		input.UserDrafts,
+		input.Enabled,
+	))
+}
+
+// DeleteContentCategory kategoriyi siler. Yayın geçmişi ve talepler kategori adıyla saklandığından korunur.
+// Kategori yoksa sql.ErrNoRows döner.
+func (r *Repository) DeleteContentCategory(ctx context.Context, categoryType string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM github_content_categories WHERE type = $1`, categoryType)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result)
+}
+
+func scanContentCategory(row rowScanner) (*types.ContentCategory, error) {
+	var category types.ContentCategory
+	err := row.Scan(
+		&category.Type,
+		&category.Name,
+		&category.Description,
+		&category.RepoOwner,
+		&category.RepoName,
+		&category.BaseBranch,
+		&category.Path,
+		pq.Array(&category.Extensions),
+		&category.MaxSize,
+		&category.Validator,
+		&category.DraftBranch,
+		&category.SchemaPath,
+		&category.UserDrafts,
+		&category.Enabled,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
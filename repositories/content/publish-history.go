@@ -0,0 +1,154 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+const publishRecordColumns = `
+	id, category, source, pr_number, merge_sha, base_sha, files, message, report,
+	published_by, rollback_of, rolled_back_at, created_at
+`
+
+// CreatePublishRecord main'e alınan bir yayını veya geri alma işlemini geçmişe kaydeder
+func (r *Repository) CreatePublishRecord(ctx context.Context, input types.CreatePublishRecordInput) (*types.PublishRecord, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	files := input.Files
+	if files == nil {
+		files = []types.PublishedFile{}
+	}
+	filesJSON, err := json.Marshal(files)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO github_publish_history (
+			id, category, source, pr_number, merge_sha, base_sha, files, message, report, published_by, rollback_of
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+		RETURNING ` + publishRecordColumns
+
+	return scanPublishRecord(r.db.QueryRowContext(
+		ctx,
+		query,
+		id,
+		input.Category,
+		input.Source,
+		input.PRNumber,
+		input.MergeSHA,
+		input.BaseSHA,
+		filesJSON,
+		input.Message,
+		input.Report,
+		input.PublishedBy,
+		input.RollbackOf,
+	))
+}
+
+// GetPublishRecordByID tek bir yayın kaydını getirir. Kayıt yoksa nil döner.
+func (r *Repository) GetPublishRecordByID(ctx context.Context, id uuid.UUID) (*types.PublishRecord, error) {
+	query := `SELECT ` + publishRecordColumns + ` FROM github_publish_history WHERE id = $1`
+
+	record, err := scanPublishRecord(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// ListPublishRecords bir kategorinin yayın geçmişini en yeniden eskiye doğru getirir
+func (r *Repository) ListPublishRecords(ctx context.Context, category string, limit int) ([]types.PublishRecord, error) {
+	query := `
+		SELECT ` + publishRecordColumns + `
+		FROM github_publish_history
+		WHERE category = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, category, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := []types.PublishRecord{}
+	for rows.Next() {
+		record, err := scanPublishRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// MarkPublishRecordRolledBack yayını geri alınmış olarak işaretler.
+// Yayın daha önce geri alınmışsa sql.ErrNoRows döner; böylece aynı yayın iki kez geri alınamaz.
+func (r *Repository) MarkPublishRecordRolledBack(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE github_publish_history
+		SET rolled_back_at = NOW()
+		WHERE id = $1 AND rolled_back_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result)
+}
+
+// UnmarkPublishRecordRolledBack geri alma işlemi başarısız olduğunda işareti kaldırır
+func (r *Repository) UnmarkPublishRecordRolledBack(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE github_publish_history SET rolled_back_at = NULL WHERE id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+func scanPublishRecord(row rowScanner) (*types.PublishRecord, error) {
+	var record types.PublishRecord
+	var filesJSON []byte
+	err := row.Scan(
+		&record.ID,
+		&record.Category,
+		&record.Source,
+		&record.PRNumber,
+		&record.MergeSHA,
+		&record.BaseSHA,
+		&filesJSON,
+		&record.Message,
+		&record.Report,
+		&record.PublishedBy,
+		&record.RollbackOf,
+		&record.RolledBackAt,
+		&record.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(filesJSON, &record.Files); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
@@ -0,0 +1,255 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+const publishRequestColumns = `
+	id, category, branch, head_sha, pr_number, pr_url, message, status,
+	requested_by, reviewed_by, review_note, reviewed_at, result_message, created_at, updated_at
+`
+
+// CreatePublishRequest yeni bir bekleyen yayınlama talebi oluşturur.
+// Kategori için açık bir talep varsa benzersiz indeks nedeniyle hata döner.
+func (r *Repository) CreatePublishRequest(ctx context.Context, input types.CreatePublishRequestInput) (*types.PublishRequest, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO github_publish_requests (
+			id, category, branch, head_sha, pr_number, pr_url, message, requested_by
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+		RETURNING ` + publishRequestColumns
+
+	return scanPublishRequest(r.db.QueryRowContext(
+		ctx,
+		query,
+		id,
+		input.Category,
+		input.Branch,
+		input.HeadSHA,
+		input.PRNumber,
+		input.PRURL,
+		input.Message,
+		input.RequestedBy,
+	))
+}
+
+// GetPublishRequestByID tek bir yayınlama talebini getirir. Talep yoksa nil döner.
+func (r *Repository) GetPublishRequestByID(ctx context.Context, id uuid.UUID) (*types.PublishRequest, error) {
+	query := `SELECT ` + publishRequestColumns + ` FROM github_publish_requests WHERE id = $1`
+
+	request, err := scanPublishRequest(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+// GetOpenPublishRequest kategorideki taslak branch'inin bekleyen veya birleştirilmekte olan talebini getirir.
+// Açık talep yoksa nil döner.
+func (r *Repository) GetOpenPublishRequest(ctx context.Context, category, branch string) (*types.PublishRequest, error) {
+	query := `
+		SELECT ` + publishRequestColumns + `
+		FROM github_publish_requests
+		WHERE category = $1 AND branch = $2 AND status IN ('pending', 'approved')
+	`
+
+	request, err := scanPublishRequest(r.db.QueryRowContext(ctx, query, category, branch))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+// ListPublishRequests yayınlama taleplerini en yeniden eskiye doğru listeler. Boş filtreler uygulanmaz.
+func (r *Repository) ListPublishRequests(ctx context.Context, status, category string, limit int) ([]types.PublishRequest, error) {
+	query := `
+		SELECT ` + publishRequestColumns + `
+		FROM github_publish_requests
+		WHERE ($1 = '' OR status::text = $1) AND ($2 = '' OR category = $2)
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, status, category, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := []types.PublishRequest{}
+	for rows.Next() {
+		request, err := scanPublishRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// ReviewPublishRequest bekleyen bir talebi 'approved' veya 'rejected' durumuna geçirir.
+// Talep bekleyen durumda değilse (başka biri önce incelemişse) sql.ErrNoRows döner.
+func (r *Repository) ReviewPublishRequest(ctx context.Context, id uuid.UUID, status types.PublishRequestStatus, reviewerID uuid.UUID, note *string) error {
+	query := `
+		UPDATE github_publish_requests
+		SET status = $2, reviewed_by = $3, review_note = $4, reviewed_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, status, reviewerID, note)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result)
+}
+
+// CompletePublishRequest onaylanmış bir talebin birleştirme sonucunu ('published' veya 'failed') kaydeder
+func (r *Repository) CompletePublishRequest(ctx context.Context, id uuid.UUID, status types.PublishRequestStatus, resultMessage string) error {
+	query := `
+		UPDATE github_publish_requests
+		SET status = $2, result_message = $3
+		WHERE id = $1 AND status = 'approved'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, status, resultMessage)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result)
+}
+
+// CreatePublishRequestComment bir talebe yorum ekler
+func (r *Repository) CreatePublishRequestComment(ctx context.Context, requestID, userID uuid.UUID, body string) (*types.PublishRequestComment, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		WITH inserted AS (
+			INSERT INTO github_publish_request_comments (id, request_id, user_id, body)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id, request_id, user_id, body, created_at
+		)
+		SELECT i.id, i.request_id, i.user_id, COALESCE(u.email, ''), i.body, i.created_at
+		FROM inserted i
+		LEFT JOIN users u ON u.id = i.user_id
+	`
+
+	var comment types.PublishRequestComment
+	err = r.db.QueryRowContext(ctx, query, id, requestID, userID, body).Scan(
+		&comment.ID,
+		&comment.RequestID,
+		&comment.UserID,
+		&comment.UserEmail,
+		&comment.Body,
+		&comment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// GetPublishRequestComments bir talebin yorumlarını yazılma sırasıyla getirir
+func (r *Repository) GetPublishRequestComments(ctx context.Context, requestID uuid.UUID) ([]types.PublishRequestComment, error) {
+	query := `
+		SELECT c.id, c.request_id, c.user_id, COALESCE(u.email, ''), c.body, c.created_at
+		FROM github_publish_request_comments c
+		LEFT JOIN users u ON u.id = c.user_id
+		WHERE c.request_id = $1
+		ORDER BY c.created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := []types.PublishRequestComment{}
+	for rows.Next() {
+		var comment types.PublishRequestComment
+		err := rows.Scan(
+			&comment.ID,
+			&comment.RequestID,
+			&comment.UserID,
+			&comment.UserEmail,
+			&comment.Body,
+			&comment.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPublishRequest(row rowScanner) (*types.PublishRequest, error) {
+	var request types.PublishRequest
+	err := row.Scan(
+		&request.ID,
+		&request.Category,
+		&request.Branch,
+		&request.HeadSHA,
+		&request.PRNumber,
+		&request.PRURL,
+		&request.Message,
+		&request.Status,
+		&request.RequestedBy,
+		&request.ReviewedBy,
+		&request.ReviewNote,
+		&request.ReviewedAt,
+		&request.ResultMessage,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func expectAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
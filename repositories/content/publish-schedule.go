@@ -0,0 +1,211 @@
+package ContentRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+const publishScheduleColumns = `
+	id, category, branch, head_sha, publish_at, allow_draft_changes, message, status,
+	result_message, created_by, cancelled_by, executed_at, created_at, updated_at
+`
+
+// CreatePublishSchedule yeni bir zamanlanmış yayınlama oluşturur.
+// Kategori için bekleyen bir zamanlama varsa benzersiz indeks nedeniyle hata döner.
+func (r *Repository) CreatePublishSchedule(ctx context.Context, input types.CreatePublishScheduleInput) (*types.PublishSchedule, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO github_publish_schedules (
+			id, category, branch, head_sha, publish_at, allow_draft_changes, message, created_by
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+		RETURNING ` + publishScheduleColumns
+
+	return scanPublishSchedule(r.db.QueryRowContext(
+		ctx,
+		query,
+		id,
+		input.Category,
+		input.Branch,
+		input.HeadSHA,
+		input.PublishAt,
+		input.AllowDraftChanges,
+		input.Message,
+		input.CreatedBy,
+	))
+}
+
+// GetPublishScheduleByID tek bir zamanlamayı getirir. Kayıt yoksa nil döner.
+func (r *Repository) GetPublishScheduleByID(ctx context.Context, id uuid.UUID) (*types.PublishSchedule, error) {
+	query := `SELECT ` + publishScheduleColumns + ` FROM github_publish_schedules WHERE id = $1`
+
+	schedule, err := scanPublishSchedule(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// GetOpenPublishSchedule kategorideki taslak branch'inin bekleyen veya çalışmakta olan zamanlamasını getirir. Yoksa nil döner.
+func (r *Repository) GetOpenPublishSchedule(ctx context.Context, category, branch string) (*types.PublishSchedule, error) {
+	query := `
+		SELECT ` + publishScheduleColumns + `
+		FROM github_publish_schedules
+		WHERE category = $1 AND branch = $2 AND status IN ('scheduled', 'running')
+	`
+
+	schedule, err := scanPublishSchedule(r.db.QueryRowContext(ctx, query, category, branch))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// ListPublishSchedules zamanlamaları yayınlanma zamanına göre en yeniden eskiye listeler. Boş filtreler uygulanmaz.
+func (r *Repository) ListPublishSchedules(ctx context.Context, status, category string, limit int) ([]types.PublishSchedule, error) {
+	query := `
+		SELECT ` + publishScheduleColumns + `
+		FROM github_publish_schedules
+		WHERE ($1 = '' OR status::text = $1) AND ($2 = '' OR category = $2)
+		ORDER BY publish_at DESC
+		LIMIT $3
+	`
+
+	return r.queryPublishSchedules(ctx, query, status, category, limit)
+}
+
+// GetScheduledPublishSchedules çalışma zamanı bekleyen tüm zamanlamaları getirir.
+// Sunucu açılışında otomasyon kayıtlarını yeniden oluşturmak için kullanılır.
+func (r *Repository) GetScheduledPublishSchedules(ctx context.Context) ([]types.PublishSchedule, error) {
+	query := `
+		SELECT ` + publishScheduleColumns + `
+		FROM github_publish_schedules
+		WHERE status = 'scheduled'
+		ORDER BY publish_at
+	`
+
+	return r.queryPublishSchedules(ctx, query)
+}
+
+// FailInterruptedPublishSchedules sunucu kapanırken yarıda kalmış ('running') zamanlamaları başarısız olarak işaretler.
+// İşaretlenen kayıt sayısını döndürür.
+func (r *Repository) FailInterruptedPublishSchedules(ctx context.Context, message string) (int64, error) {
+	query := `
+		UPDATE github_publish_schedules
+		SET status = 'failed', result_message = $1
+		WHERE status = 'running'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, message)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// CancelPublishSchedule bekleyen bir zamanlamayı iptal eder. Zamanlama artık beklemiyorsa sql.ErrNoRows döner.
+func (r *Repository) CancelPublishSchedule(ctx context.Context, id, userID uuid.UUID) error {
+	query := `
+		UPDATE github_publish_schedules
+		SET status = 'cancelled', cancelled_by = $2
+		WHERE id = $1 AND status = 'scheduled'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result)
+}
+
+// StartPublishSchedule bekleyen zamanlamayı 'running' durumuna geçirir.
+// Zamanlama iptal edilmiş veya başka bir çalıştırma tarafından alınmışsa sql.ErrNoRows döner.
+func (r *Repository) StartPublishSchedule(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE github_publish_schedules
+		SET status = 'running', executed_at = NOW()
+		WHERE id = $1 AND status = 'scheduled'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result)
+}
+
+// CompletePublishSchedule çalışan zamanlamanın sonucunu ('published', 'skipped' veya 'failed') kaydeder
+func (r *Repository) CompletePublishSchedule(ctx context.Context, id uuid.UUID, status types.PublishScheduleStatus, resultMessage string) error {
+	query := `
+		UPDATE github_publish_schedules
+		SET status = $2, result_message = $3
+		WHERE id = $1 AND status = 'running'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, status, resultMessage)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result)
+}
+
+func (r *Repository) queryPublishSchedules(ctx context.Context, query string, args ...any) ([]types.PublishSchedule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := []types.PublishSchedule{}
+	for rows.Next() {
+		schedule, err := scanPublishSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, *schedule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func scanPublishSchedule(row rowScanner) (*types.PublishSchedule, error) {
+	var schedule types.PublishSchedule
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.Category,
+		&schedule.Branch,
+		&schedule.HeadSHA,
+		&schedule.PublishAt,
+		&schedule.AllowDraftChanges,
+		&schedule.Message,
+		&schedule.Status,
+		&schedule.ResultMessage,
+		&schedule.CreatedBy,
+		&schedule.CancelledBy,
+		&schedule.ExecutedAt,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
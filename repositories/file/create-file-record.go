@@ -19,22 +19,33 @@ func (r *Repository) CreateFileRecord(ctx context.Context, input types.SaveFileI
 	// 2. SQL sorgusunu, backend'de oluşturulan ID'yi içerecek şekilde düzenle.
 	query := `
 		INSERT INTO files (
-			id, url, filename, file_type, file_category, size_in_bytes, status
+			id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, status, uploaded_by
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, 'active'
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 		)
 	` // RETURNING id kaldırıldı.
 
+	// Tarama gerektiren dosyalar karantinada oluşturulur
+	status := input.Status
+	if status == "" {
+		status = types.FileRecordStatusActive
+	}
+
 	// 3. Sorguyu çalıştır.
 	_, err = r.db.ExecContext(
 		ctx,
 		query,
 		newFileID, // Backend'de oluşturulan ID
 		input.URL,
+		input.ObjectKey,
 		input.Filename,
 		input.FileType,
 		input.FileCategory,
 		input.SizeInBytes,
+		input.Visibility,
+		input.ContentHash,
+		status,
+		input.UploadedBy,
 	)
 
 	if err != nil {
@@ -0,0 +1,49 @@
+package FileRepository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+// CreateMultipartUpload, başlatılan bir çok parçalı yüklemeyi 'files_multipart_uploads' tablosuna kaydeder.
+func (r *Repository) CreateMultipartUpload(ctx context.Context, input types.MultipartUploadInput) (uuid.UUID, error) {
+	newUploadID, err := uuid.NewV7()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	query := `
+		INSERT INTO files_multipart_uploads (
+			id, upload_id, object_key, upload_url, filename, file_type, file_category,
+			size_in_bytes, visibility, part_size, part_count, uploaded_by, expires_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+		)
+	`
+
+	_, err = r.db.ExecContext(
+		ctx,
+		query,
+		newUploadID,
+		input.UploadID,
+		input.ObjectKey,
+		input.UploadURL,
+		input.Filename,
+		input.FileType,
+		input.FileCategory,
+		input.SizeInBytes,
+		input.Visibility,
+		input.PartSize,
+		input.PartCount,
+		input.UploadedBy,
+		input.ExpiresAt,
+	)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return newUploadID, nil
+}
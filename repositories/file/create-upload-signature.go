@@ -20,9 +20,10 @@ func (r *Repository) CreateUploadSignature(ctx context.Context, input types.Uplo
 	// 2. SQL sorgusunu, backend'de oluşturulan ID'yi içerecek şekilde düzenle.
 	query := `
 		INSERT INTO files_signatures (
-			id, presigned_url, upload_url, filename, file_type, file_category, expires_at
+			id, presigned_url, upload_url, object_key, filename, file_type, file_category, visibility, content_hash,
+			size_in_bytes, uploaded_by, expires_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 		)
 	` // RETURNING id kaldırıldı, çünkü ID'yi zaten biliyoruz ve fonksiyonda döndürüyoruz.
 
@@ -34,9 +35,14 @@ func (r *Repository) CreateUploadSignature(ctx context.Context, input types.Uplo
 		newSignatureID, // Backend'de oluşturulan ID
 		input.PresignedURL,
 		input.UploadURL,
+		input.ObjectKey,
 		input.Filename,
 		input.FileType,
 		input.FileCategory,
+		input.Visibility,
+		input.ContentHash,
+		input.SizeInBytes,
+		input.UploadedBy,
 		input.ExpiresAt,
 	)
 
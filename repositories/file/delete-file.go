@@ -7,13 +7,14 @@ import (
 	"github.com/google/uuid"
 )
 
-// DeleteImage bir resmi siler (veya durumunu 'deleted' olarak günceller)
+// DeleteFile bir dosyayı çöp kutusuna taşır (durumunu 'deleted' olarak günceller).
+// Storage üzerindeki nesne silinmez; saklama süresi dolunca PurgeFile ile kalıcı olarak silinir.
 func (r *Repository) DeleteFile(ctx context.Context, fileID uuid.UUID) error {
 	// Soft delete - durumu 'deleted' olarak güncelle
 	query := `
 		UPDATE files
-		SET status = 'deleted', updated_at = NOW()
-		WHERE id = $1
+		SET status = 'deleted', deleted_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'active'
 	`
 
 	result, err := r.db.ExecContext(ctx, query, fileID)
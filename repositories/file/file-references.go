@@ -0,0 +1,216 @@
+package FileRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// GetFileReferences bir dosyayı nesne anahtarı üzerinden kullanan tüm içerik dosyalarını ve kullanıcıları getirir.
+// İçeriklerde dosyanın hangi domain ile (CDN, eski bucket adresi vb.) geçtiği önemsizdir.
+func (r *Repository) GetFileReferences(ctx context.Context, objectKey string) ([]types.FileReference, error) {
+	query := `
+		SELECT id, file_url, object_key, source_type, content_category, content_path, content_branch, user_id, created_at
+		FROM files_references
+		WHERE object_key = $1
+		ORDER BY source_type, content_category, content_path, content_branch
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var references []types.FileReference
+	for rows.Next() {
+		var reference types.FileReference
+		err := rows.Scan(
+			&reference.ID,
+			&reference.FileURL,
+			&reference.ObjectKey,
+			&reference.SourceType,
+			&reference.ContentCategory,
+			&reference.ContentPath,
+			&reference.ContentBranch,
+			&reference.UserID,
+			&reference.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		references = append(references, reference)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return references, nil
+}
+
+// ReplaceContentFileReferences tek bir içerik dosyasının referanslarını verilen URL listesiyle değiştirir.
+// Sadece nesne anahtarı files tablosunda karşılığı olan URL'ler kaydedilir.
+func (r *Repository) ReplaceContentFileReferences(ctx context.Context, category, branch, path string, urls []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		DELETE FROM files_references
+		WHERE source_type = 'content' AND content_category = $1 AND content_branch = $2 AND content_path = $3
+	`
+	if _, err := tx.ExecContext(ctx, query, category, branch, path); err != nil {
+		return err
+	}
+
+	if err := insertContentReferencesTx(ctx, tx, category, branch, path, urls); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReplaceCategoryFileReferences bir kategori ve branch'e ait tüm içerik referanslarını yeniden yazar.
+// references, dosya yolundan o dosyada geçen URL'lere bir haritadır. Periyodik tarama tarafından kullanılır.
+func (r *Repository) ReplaceCategoryFileReferences(ctx context.Context, category, branch string, references map[string][]string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		DELETE FROM files_references
+		WHERE source_type = 'content' AND content_category = $1 AND content_branch = $2
+	`
+	if _, err := tx.ExecContext(ctx, query, category, branch); err != nil {
+		return err
+	}
+
+	for path, urls := range references {
+		if err := insertContentReferencesTx(ctx, tx, category, branch, path, urls); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// PruneCategoryFileReferences bir kategorinin verilen branch'ler dışındaki içerik referanslarını siler.
+// Silinen kişisel taslak branch'lerinin referansları periyodik tarama sırasında bu yolla temizlenir.
+func (r *Repository) PruneCategoryFileReferences(ctx context.Context, category string, branches []string) error {
+	query := `
+		DELETE FROM files_references
+		WHERE source_type = 'content' AND content_category = $1 AND NOT (content_branch = ANY($2))
+	`
+	_, err := r.db.ExecContext(ctx, query, category, pq.Array(branches))
+	return err
+}
+
+// RebuildAvatarFileReferences kullanıcı avatarlarından kaynaklanan referansları user_details tablosundan yeniden oluşturur.
+// Oluşturulan referans sayısını döndürür.
+func (r *Repository) RebuildAvatarFileReferences(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM files_references WHERE source_type = 'user_avatar'`); err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT user_id, avatar_url
+		FROM user_details
+		WHERE avatar_url IS NOT NULL AND avatar_url <> ''
+	`)
+	if err != nil {
+		return 0, err
+	}
+
+	type avatar struct{ userID, url string }
+	var avatars []avatar
+	for rows.Next() {
+		var a avatar
+		if err := rows.Scan(&a.userID, &a.url); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		avatars = append(avatars, a)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	// Sadece nesne anahtarı files tablosunda karşılığı olan avatarlar kaydedilir
+	query := `
+		INSERT INTO files_references (id, file_url, object_key, source_type, user_id)
+		SELECT $1::text, $2::text, f.object_key, 'user_avatar', $4::text
+		FROM files f
+		WHERE f.object_key = $3::text
+	`
+
+	created := 0
+	for _, a := range avatars {
+		objectKey := utils.ObjectKeyFromFileURL(a.url)
+		if objectKey == "" {
+			continue
+		}
+
+		id, err := uuid.NewV7()
+		if err != nil {
+			return 0, err
+		}
+
+		result, err := tx.ExecContext(ctx, query, id, a.url, objectKey, a.userID)
+		if err != nil {
+			return 0, err
+		}
+		if rowsAffected, err := result.RowsAffected(); err == nil {
+			created += int(rowsAffected)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return created, nil
+}
+
+// insertContentReferencesTx, files tablosunda karşılığı olan URL'ler için içerik referansı ekler.
+// Eşleştirme, URL'den utils.ObjectKeyFromFileURL ile elde edilen nesne anahtarı ile yapılır.
+func insertContentReferencesTx(ctx context.Context, tx *sql.Tx, category, branch, path string, urls []string) error {
+	query := `
+		INSERT INTO files_references (id, file_url, object_key, source_type, content_category, content_path, content_branch)
+		SELECT $1::text, $2::text, f.object_key, 'content', $3::text, $4::text, $5::text
+		FROM files f
+		WHERE f.object_key = $6::text
+	`
+
+	for _, url := range urls {
+		objectKey := utils.ObjectKeyFromFileURL(url)
+		if objectKey == "" {
+			continue
+		}
+
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, id, url, category, path, branch, objectKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
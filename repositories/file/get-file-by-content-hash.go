@@ -0,0 +1,46 @@
+package FileRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetFileByContentHash aynı kategoride, aynı içerik özetine sahip aktif dosyayı getirir
+func (r *Repository) GetFileByContentHash(ctx context.Context, contentHash string, category string) (*types.File, error) {
+	query := `
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, created_at, updated_at
+		FROM files
+		WHERE content_hash = $1 AND file_category = $2 AND status = 'active'
+		ORDER BY created_at ASC
+		LIMIT 1
+	`
+
+	var file types.File
+	err := r.db.QueryRowContext(ctx, query, contentHash, category).Scan(
+		&file.ID,
+		&file.URL,
+		&file.ObjectKey,
+		&file.Filename,
+		&file.FileType,
+		&file.FileCategory,
+		&file.SizeInBytes,
+		&file.Visibility,
+		&file.ContentHash,
+		pq.Array(&file.Tags),
+		&file.Status,
+		&file.CreatedAt,
+		&file.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Aynı içerikte dosya yok
+		}
+		return nil, err
+	}
+
+	return &file, nil
+}
@@ -5,13 +5,14 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/okanay/backend-template/types"
 )
 
 // GetImageByID bir resmi ID'ye göre getirir
 func (r *Repository) GetFileByID(ctx context.Context, fileID uuid.UUID) (*types.File, error) {
 	query := `
-		SELECT id, url, filename, file_type, file_category, size_in_bytes, status, created_at, updated_at
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, created_at, updated_at
 		FROM files
 		WHERE id = $1 AND status = 'active'
 	`
@@ -20,10 +21,14 @@ func (r *Repository) GetFileByID(ctx context.Context, fileID uuid.UUID) (*types.
 	err := r.db.QueryRowContext(ctx, query, fileID).Scan(
 		&file.ID,
 		&file.URL,
+		&file.ObjectKey,
 		&file.Filename,
 		&file.FileType,
 		&file.FileCategory,
 		&file.SizeInBytes,
+		&file.Visibility,
+		&file.ContentHash,
+		pq.Array(&file.Tags),
 		&file.Status,
 		&file.CreatedAt,
 		&file.UpdatedAt,
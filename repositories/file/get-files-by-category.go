@@ -3,13 +3,14 @@ package FileRepository
 import (
 	"context"
 
+	"github.com/lib/pq"
 	"github.com/okanay/backend-template/types"
 )
 
 // GetImagesByUserID kullanıcıya ait resimleri getirir
 func (r *Repository) GetFilesByCategory(ctx context.Context, category string) ([]types.File, error) {
 	query := `
-		SELECT id, url, filename, file_type, file_category, size_in_bytes, status, created_at, updated_at
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, created_at, updated_at
 		FROM files
 		WHERE file_category = $1 AND status = 'active'
 		ORDER BY created_at DESC
@@ -27,10 +28,14 @@ func (r *Repository) GetFilesByCategory(ctx context.Context, category string) ([
 		err := rows.Scan(
 			&file.ID,
 			&file.URL,
+			&file.ObjectKey,
 			&file.Filename,
 			&file.FileType,
 			&file.FileCategory,
 			&file.SizeInBytes,
+			&file.Visibility,
+			&file.ContentHash,
+			pq.Array(&file.Tags),
 			&file.Status,
 			&file.CreatedAt,
 			&file.UpdatedAt,
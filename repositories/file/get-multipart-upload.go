@@ -0,0 +1,91 @@
+package FileRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetMultipartUploadByID bir çok parçalı yükleme kaydını ID'ye göre getirir
+func (r *Repository) GetMultipartUploadByID(ctx context.Context, id uuid.UUID) (*types.MultipartUpload, error) {
+	query := `
+		SELECT id, upload_id, object_key, upload_url, filename, file_type, file_category,
+			size_in_bytes, visibility, part_size, part_count, status, uploaded_by, expires_at, created_at, updated_at
+		FROM files_multipart_uploads
+		WHERE id = $1
+	`
+
+	upload, err := scanMultipartUpload(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Kayıt bulunamadı
+		}
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// GetExpiredMultipartUploads süresi dolmuş ve hâlâ bekleyen yükleme kayıtlarını getirir
+func (r *Repository) GetExpiredMultipartUploads(ctx context.Context) ([]types.MultipartUpload, error) {
+	query := `
+		SELECT id, upload_id, object_key, upload_url, filename, file_type, file_category,
+			size_in_bytes, visibility, part_size, part_count, status, uploaded_by, expires_at, created_at, updated_at
+		FROM files_multipart_uploads
+		WHERE status = 'pending' AND expires_at < NOW()
+		ORDER BY expires_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var uploads []types.MultipartUpload
+	for rows.Next() {
+		upload, err := scanMultipartUpload(rows)
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, *upload)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return uploads, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMultipartUpload(row rowScanner) (*types.MultipartUpload, error) {
+	var upload types.MultipartUpload
+	err := row.Scan(
+		&upload.ID,
+		&upload.UploadID,
+		&upload.ObjectKey,
+		&upload.UploadURL,
+		&upload.Filename,
+		&upload.FileType,
+		&upload.FileCategory,
+		&upload.SizeInBytes,
+		&upload.Visibility,
+		&upload.PartSize,
+		&upload.PartCount,
+		&upload.Status,
+		&upload.UploadedBy,
+		&upload.ExpiresAt,
+		&upload.CreatedAt,
+		&upload.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
@@ -10,7 +10,7 @@ import (
 
 func (r *Repository) GetUploadSignatureByID(ctx context.Context, signatureID uuid.UUID) (*types.UploadSignature, error) {
 	query := `
-		SELECT id, presigned_url, upload_url, filename, file_type, file_category, expires_at, completed, created_at
+		SELECT id, presigned_url, upload_url, object_key, filename, file_type, file_category, visibility, content_hash, size_in_bytes, uploaded_by, expires_at, completed, created_at
 		FROM files_signatures
 		WHERE id = $1
 	`
@@ -20,9 +20,14 @@ func (r *Repository) GetUploadSignatureByID(ctx context.Context, signatureID uui
 		&signature.ID,
 		&signature.PresignedURL,
 		&signature.UploadURL,
+		&signature.ObjectKey,
 		&signature.Filename,
 		&signature.FileType,
 		&signature.FileCategory,
+		&signature.Visibility,
+		&signature.ContentHash,
+		&signature.SizeInBytes,
+		&signature.UploadedBy,
 		&signature.ExpiresAt,
 		&signature.Completed,
 		&signature.CreatedAt,
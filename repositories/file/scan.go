@@ -0,0 +1,206 @@
+package FileRepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetQuarantinedFiles verilen tarihten önce oluşturulmuş ve hâlâ taranmayı bekleyen dosyaları getirir
+func (r *Repository) GetQuarantinedFiles(ctx context.Context, createdBefore time.Time) ([]types.File, error) {
+	query := `
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, created_at, updated_at
+		FROM files
+		WHERE status = 'quarantined' AND created_at < $1
+		ORDER BY created_at
+	`
+	return r.queryScanFiles(ctx, query, createdBefore)
+}
+
+// GetExpiredScanFailedFiles verilen tarihten önce scan_failed durumuna alınmış dosyaları getirir
+func (r *Repository) GetExpiredScanFailedFiles(ctx context.Context, failedBefore time.Time) ([]types.File, error) {
+	query := `
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, created_at, updated_at
+		FROM files
+		WHERE status = 'scan_failed' AND updated_at < $1
+		ORDER BY updated_at
+	`
+	return r.queryScanFiles(ctx, query, failedBefore)
+}
+
+func (r *Repository) queryScanFiles(ctx context.Context, query string, args ...any) ([]types.File, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var files []types.File
+	for rows.Next() {
+		var file types.File
+		err := rows.Scan(
+			&file.ID,
+			&file.URL,
+			&file.ObjectKey,
+			&file.Filename,
+			&file.FileType,
+			&file.FileCategory,
+			&file.SizeInBytes,
+			&file.Visibility,
+			&file.ContentHash,
+			pq.Array(&file.Tags),
+			&file.Status,
+			&file.CreatedAt,
+			&file.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// ActivateQuarantinedFile taraması temiz çıkan dosyayı aktif hale getirir.
+// Nesne karantina sırasında private önekte tutulduğundan kaydın yeni nesne anahtarı ve URL'si de yazılır.
+func (r *Repository) ActivateQuarantinedFile(ctx context.Context, fileID uuid.UUID, objectKey, url string) error {
+	query := `
+		UPDATE files
+		SET status = 'active', object_key = $2, url = $3, updated_at = NOW()
+		WHERE id = $1 AND status = 'quarantined'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, fileID, objectKey, url)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// MarkFileScanFailed taranamayan karantinadaki dosyayı scan_failed durumuna alır; dosya bir daha taranmaz
+func (r *Repository) MarkFileScanFailed(ctx context.Context, fileID uuid.UUID) error {
+	query := `
+		UPDATE files
+		SET status = 'scan_failed', updated_at = NOW()
+		WHERE id = $1 AND status = 'quarantined'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, fileID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountFileScanErrors dosyanın hata ile sonuçlanan tarama sayısını döndürür
+func (r *Repository) CountFileScanErrors(ctx context.Context, fileID uuid.UUID) (int, error) {
+	query := `SELECT COUNT(*) FROM files_scan_events WHERE file_id = $1 AND result = 'error'`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, fileID).Scan(&count)
+	return count, err
+}
+
+// CreateFileScanEvent bir tarama sonucunu files_scan_events tablosuna kaydeder
+func (r *Repository) CreateFileScanEvent(ctx context.Context, event types.FileScanEvent) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO files_scan_events (
+			id, file_id, object_key, filename, file_category, scanner, result, signature, message
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err = r.db.ExecContext(
+		ctx,
+		query,
+		id,
+		event.FileID,
+		event.ObjectKey,
+		event.Filename,
+		event.FileCategory,
+		event.Scanner,
+		event.Result,
+		event.Signature,
+		event.Message,
+	)
+	return err
+}
+
+// GetFileScanEvents tarama kayıtlarını en yeniden eskiye doğru getirir. result boşsa tüm sonuçlar döner.
+func (r *Repository) GetFileScanEvents(ctx context.Context, result string, limit int) ([]types.FileScanEvent, error) {
+	query := `
+		SELECT id, file_id, object_key, filename, file_category, scanner, result, signature, message, created_at
+		FROM files_scan_events
+		WHERE $1 = '' OR result::text = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, result, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []types.FileScanEvent
+	for rows.Next() {
+		var event types.FileScanEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.FileID,
+			&event.ObjectKey,
+			&event.Filename,
+			&event.FileCategory,
+			&event.Scanner,
+			&event.Result,
+			&event.Signature,
+			&event.Message,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,114 @@
+package FileRepository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+// storageUsageQuery, kullanıcı ve kategori bazında kullanılan ve rezerve edilen alanı hesaplar.
+// Çöp kutusundaki ve taranamayan (scan_failed) dosyalar kalıcı olarak silinene kadar yer kapladığından kullanıma dahildir.
+// Rezervasyonlar, tamamlanmamış ve süresi dolmamış imzalar ile bekleyen multipart yüklemelerdir;
+// süresi dolan rezervasyonlar hesaba katılmaz, yani otomatik olarak serbest kalır.
+const storageUsageQuery = `
+	SELECT storage_usage.uploaded_by, COALESCE(u.email, ''), storage_usage.category,
+		SUM(storage_usage.used_bytes), SUM(storage_usage.reserved_bytes), SUM(storage_usage.file_count)
+	FROM (
+		SELECT uploaded_by, COALESCE(file_category, '') AS category,
+			size_in_bytes AS used_bytes, 0 AS reserved_bytes, 1 AS file_count
+		FROM files
+		WHERE uploaded_by IS NOT NULL AND status IN ('active', 'quarantined', 'scan_failed', 'deleted')
+
+		UNION ALL
+
+		SELECT uploaded_by, COALESCE(file_category, ''), 0, size_in_bytes, 0
+		FROM files_signatures
+		WHERE uploaded_by IS NOT NULL AND completed = FALSE AND expires_at > NOW()
+
+		UNION ALL
+
+		SELECT uploaded_by, COALESCE(file_category, ''), 0, size_in_bytes, 0
+		FROM files_multipart_uploads
+		WHERE uploaded_by IS NOT NULL AND status = 'pending' AND expires_at > NOW()
+	) storage_usage
+	LEFT JOIN users u ON u.id = storage_usage.uploaded_by
+	WHERE $1 = '' OR storage_usage.uploaded_by = $1
+	GROUP BY storage_usage.uploaded_by, u.email, storage_usage.category
+	ORDER BY storage_usage.uploaded_by, storage_usage.category
+`
+
+// GetStorageUsage bir kullanıcının kategori bazında depolama kullanımını getirir
+func (r *Repository) GetStorageUsage(ctx context.Context, userID uuid.UUID) (*types.FileStorageUsage, error) {
+	usages, err := r.queryStorageUsage(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usages) == 0 {
+		return &types.FileStorageUsage{UserID: userID.String(), Categories: []types.FileCategoryUsage{}}, nil
+	}
+	return &usages[0], nil
+}
+
+// GetStorageUsageBreakdown tüm kullanıcıların depolama kullanımını en çok yer kaplayandan başlayarak getirir
+func (r *Repository) GetStorageUsageBreakdown(ctx context.Context) ([]types.FileStorageUsage, error) {
+	return r.queryStorageUsage(ctx, "")
+}
+
+// DeleteExpiredUploadSignatures verilen tarihten önce süresi dolmuş ve tamamlanmamış imza kayıtlarını siler.
+// Silinen kayıt sayısını döndürür.
+func (r *Repository) DeleteExpiredUploadSignatures(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	query := `
+		DELETE FROM files_signatures
+		WHERE completed = FALSE AND expires_at < $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, expiredBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *Repository) queryStorageUsage(ctx context.Context, userID string) ([]types.FileStorageUsage, error) {
+	rows, err := r.db.QueryContext(ctx, storageUsageQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var usages []types.FileStorageUsage
+	for rows.Next() {
+		var uploadedBy, email string
+		var category types.FileCategoryUsage
+		if err := rows.Scan(&uploadedBy, &email, &category.Category, &category.UsedBytes, &category.ReservedBytes, &category.FileCount); err != nil {
+			return nil, err
+		}
+
+		// Sorgu kullanıcıya göre sıralı olduğundan aynı kullanıcının satırları art arda gelir
+		if len(usages) == 0 || usages[len(usages)-1].UserID != uploadedBy {
+			usages = append(usages, types.FileStorageUsage{UserID: uploadedBy, Email: email})
+		}
+
+		usage := &usages[len(usages)-1]
+		usage.UsedBytes += category.UsedBytes
+		usage.ReservedBytes += category.ReservedBytes
+		usage.FileCount += category.FileCount
+		usage.Categories = append(usage.Categories, category)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// En çok yer kaplayan kullanıcı önce gelir
+	slices.SortStableFunc(usages, func(a, b types.FileStorageUsage) int {
+		return cmp.Compare(b.UsedBytes+b.ReservedBytes, a.UsedBytes+a.ReservedBytes)
+	})
+	return usages, nil
+}
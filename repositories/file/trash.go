@@ -0,0 +1,170 @@
+package FileRepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/okanay/backend-template/types"
+)
+
+// GetTrashedFiles çöp kutusundaki dosyaları en son silinenden başlayarak getirir.
+// Kategori boşsa tüm kategoriler listelenir.
+func (r *Repository) GetTrashedFiles(ctx context.Context, category string) ([]types.File, error) {
+	query := `
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, deleted_at, created_at, updated_at
+		FROM files
+		WHERE status = 'deleted' AND ($1 = '' OR file_category = $1)
+		ORDER BY deleted_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTrashedFiles(rows)
+}
+
+// GetTrashedFileByID çöp kutusundaki tek bir dosyayı getirir. Dosya çöp kutusunda değilse nil döner.
+func (r *Repository) GetTrashedFileByID(ctx context.Context, fileID uuid.UUID) (*types.File, error) {
+	query := `
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, deleted_at, created_at, updated_at
+		FROM files
+		WHERE id = $1 AND status = 'deleted'
+	`
+
+	file, err := scanTrashedFile(r.db.QueryRowContext(ctx, query, fileID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Dosya çöp kutusunda değil
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// GetExpiredTrashedFiles verilen zamandan önce çöp kutusuna taşınmış dosyaları getirir
+func (r *Repository) GetExpiredTrashedFiles(ctx context.Context, deletedBefore time.Time) ([]types.File, error) {
+	query := `
+		SELECT id, url, object_key, filename, file_type, file_category, size_in_bytes, visibility, content_hash, tags, status, deleted_at, created_at, updated_at
+		FROM files
+		WHERE status = 'deleted' AND deleted_at < $1
+		ORDER BY deleted_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTrashedFiles(rows)
+}
+
+// TrashExpiredFiles bir kategoride verilen zamandan önce oluşturulmuş aktif dosyaları çöp kutusuna taşır.
+// Referans indeksinde kullanımda görünen dosyalar atlanır. Taşınan dosya sayısını döndürür.
+func (r *Repository) TrashExpiredFiles(ctx context.Context, category string, createdBefore time.Time) (int64, error) {
+	query := `
+		UPDATE files f
+		SET status = 'deleted', deleted_at = NOW(), updated_at = NOW()
+		WHERE f.file_category = $1 AND f.status = 'active' AND f.created_at < $2
+			AND NOT EXISTS (SELECT 1 FROM files_references fr WHERE fr.object_key = f.object_key)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, category, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// RestoreFile çöp kutusundaki bir dosyayı tekrar aktif hale getirir
+func (r *Repository) RestoreFile(ctx context.Context, fileID uuid.UUID) error {
+	query := `
+		UPDATE files
+		SET status = 'active', deleted_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = 'deleted'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, fileID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// PurgeFile dosya kaydını veritabanından kalıcı olarak siler
+func (r *Repository) PurgeFile(ctx context.Context, fileID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM files WHERE id = $1`, fileID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanTrashedFiles(rows *sql.Rows) ([]types.File, error) {
+	var files []types.File
+	for rows.Next() {
+		file, err := scanTrashedFile(rows)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func scanTrashedFile(row rowScanner) (*types.File, error) {
+	var file types.File
+	err := row.Scan(
+		&file.ID,
+		&file.URL,
+		&file.ObjectKey,
+		&file.Filename,
+		&file.FileType,
+		&file.FileCategory,
+		&file.SizeInBytes,
+		&file.Visibility,
+		&file.ContentHash,
+		pq.Array(&file.Tags),
+		&file.Status,
+		&file.DeletedAt,
+		&file.CreatedAt,
+		&file.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
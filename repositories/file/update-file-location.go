@@ -0,0 +1,34 @@
+package FileRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+// UpdateFileLocation storage üzerinde taşınan bir dosyanın nesne anahtarı, URL, kategori ve görünürlük bilgisini günceller
+func (r *Repository) UpdateFileLocation(ctx context.Context, fileID uuid.UUID, objectKey, url, category string, visibility types.FileVisibility) error {
+	query := `
+		UPDATE files
+		SET object_key = $2, url = $3, file_category = $4, visibility = $5, updated_at = NOW()
+		WHERE id = $1 AND status = 'active'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, fileID, objectKey, url, category, visibility)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
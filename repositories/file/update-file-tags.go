@@ -0,0 +1,32 @@
+package FileRepository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// UpdateFileTags bir dosyaya etiket ekler ve/veya çıkarır. Güncel etiket listesini (tekrarsız, sıralı) döndürür.
+// Dosya bulunamazsa sql.ErrNoRows döner.
+func (r *Repository) UpdateFileTags(ctx context.Context, fileID uuid.UUID, add, remove []string) ([]string, error) {
+	query := `
+		UPDATE files
+		SET tags = ARRAY(
+			SELECT DISTINCT tag
+			FROM unnest(array_cat(tags, COALESCE($2::text[], '{}'))) AS tag
+			WHERE tag <> ALL(COALESCE($3::text[], '{}'))
+			ORDER BY tag
+		), updated_at = NOW()
+		WHERE id = $1 AND status = 'active'
+		RETURNING tags
+	`
+
+	var tags []string
+	err := r.db.QueryRowContext(ctx, query, fileID, pq.Array(add), pq.Array(remove)).Scan(pq.Array(&tags))
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,34 @@
+package FileRepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/okanay/backend-template/types"
+)
+
+// UpdateMultipartUploadStatus bir çok parçalı yükleme kaydının durumunu günceller
+func (r *Repository) UpdateMultipartUploadStatus(ctx context.Context, id uuid.UUID, status types.MultipartUploadStatus) error {
+	query := `
+		UPDATE files_multipart_uploads
+		SET status = $2
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, status)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -25,9 +25,11 @@ type FallbackFunc func() (any, error)
 // CacheService, tüm cache implementasyonları için ortak arayüz
 type CacheService interface {
 	GetOrSet(group, identifier string, dest any, fallback FallbackFunc) error
+	Get(group, identifier string, dest any) bool
 	TryCache(ctx *gin.Context, group, identifier string) bool
 	SaveCache(response any, group, identifier string) error
 	SaveCacheTTL(response any, group, identifier string, ttl time.Duration) error
+	Delete(group, identifier string) error
 	ClearGroup(group string)
 	ClearAll()
 	Stop()
@@ -130,6 +132,12 @@ func (c *InMemoryCache) GetOrSet(group, identifier string, dest any, fallback Fa
 	return json.Unmarshal(bytes, dest)
 }
 
+// Get önbellekteki veriyi dest'e çözümler. Veri yoksa, süresi dolmuşsa veya bozuksa false döner.
+func (c *InMemoryCache) Get(group, identifier string, dest any) bool {
+	cachedValue, found := c.get(fmt.Sprintf("%s:%s", group, identifier))
+	return found && json.Unmarshal(cachedValue, dest) == nil
+}
+
 // TryCache önbellekteki veriyi kontrol eder ve varsa yanıt olarak döndürür
 func (c *InMemoryCache) TryCache(ctx *gin.Context, group, identifier string) bool {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
@@ -206,6 +214,7 @@ func (c *InMemoryCache) SaveCacheTTL(response any, group, identifier string, ttl
 	return nil
 }
 
+// Delete tek bir öğeyi önbellekten siler
 func (c *InMemoryCache) Delete(group, identifier string) error {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
 	c.mu.Lock()
@@ -246,8 +255,9 @@ func (c *InMemoryCache) Cleanup() {
 
 	c.mu.RLock()
 	for key, item := range c.data {
-		// Genel TTL kontrolü
-		if now.Sub(item.cachedAt) > c.ttl {
+		// Genel ve özel TTL kontrolü
+		age := now.Sub(item.cachedAt)
+		if age > c.ttl || (item.ttl > 0 && age > item.ttl) {
 			expiredKeys = append(expiredKeys, key)
 		}
 	}
@@ -271,6 +281,10 @@ func (c *InMemoryCache) get(key string) ([]byte, bool) {
 	if !exists || time.Since(item.cachedAt) > c.ttl {
 		return nil, false
 	}
+	// SaveCacheTTL ile kaydedilen öğelerin kendi süresi de kontrol edilir
+	if item.ttl > 0 && time.Since(item.cachedAt) > item.ttl {
+		return nil, false
+	}
 	return item.value, true
 }
 
@@ -340,6 +354,12 @@ func (c *RedisCache) GetOrSet(group, identifier string, dest any, fallback Fallb
 	return json.Unmarshal(bytes, dest)
 }
 
+// Get önbellekteki veriyi dest'e çözümler. Veri yoksa veya bozuksa false döner.
+func (c *RedisCache) Get(group, identifier string, dest any) bool {
+	cachedValue, found := c.get(fmt.Sprintf("%s:%s", group, identifier))
+	return found && json.Unmarshal(cachedValue, dest) == nil
+}
+
 // TryCache önbellekteki veriyi kontrol eder ve varsa yanıt olarak döndürür
 func (c *RedisCache) TryCache(ctx *gin.Context, group, identifier string) bool {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
@@ -383,6 +403,7 @@ func (c *RedisCache) SaveCacheTTL(response any, group, identifier string, ttl ti
 	return c.client.Set(c.ctx, cacheKey, jsonData, ttl).Err()
 }
 
+// Delete tek bir öğeyi önbellekten siler
 func (c *RedisCache) Delete(group, identifier string) error {
 	cacheKey := fmt.Sprintf("%s:%s", group, identifier)
 	// Redis'ten ilgili anahtarı sil
@@ -0,0 +1,107 @@
+package GithubService
+
+import (
+	"log"
+	"strings"
+)
+
+// Önbellek grupları. Branch son commit'leri repo bazında ayrı bir grupta tutulur; böylece bir PR
+// birleştirildiğinde yalnızca o reponun branch'leri temizlenebilir. Commit ve blob SHA'larına göre
+// tutulan nesneler değişmez olduğundan varsayılan süreyle saklanır ve hiç temizlenmez.
+const (
+	branchHeadCacheGroup = "github-heads"
+	objectCacheGroup     = "github-objects"
+)
+
+// cachedFile, bir commit'teki dosyanın blob SHA'sıdır; içeriği blob önbelleğinden okunur
+type cachedFile struct {
+	SHA string `json:"sha"`
+}
+
+func (r *Service) headGroup() string {
+	return branchHeadCacheGroup + ":" + r.RepoOwner + "/" + r.RepoName
+}
+
+func (r *Service) objectKey(kind string, parts ...string) string {
+	return r.RepoOwner + "/" + r.RepoName + ":" + kind + ":" + strings.Join(parts, ":")
+}
+
+// cachedObject, değişmez bir nesneyi önbellekten okur; yoksa fetch ile getirip önbelleğe yazar.
+// Hatalar önbelleğe alınmaz.
+func (r *Service) cachedObject(key string, dest any, fetch func() error) error {
+	if r.cache == nil {
+		return fetch()
+	}
+	if r.cache.Get(objectCacheGroup, key, dest) {
+		return nil
+	}
+
+	if err := fetch(); err != nil {
+		return err
+	}
+	r.saveObject(key, dest)
+	return nil
+}
+
+// saveObject, değişmez bir nesneyi önbelleğe yazar
+func (r *Service) saveObject(key string, value any) {
+	if r.cache == nil {
+		return
+	}
+	if err := r.cache.SaveCache(value, objectCacheGroup, key); err != nil {
+		log.Printf("[GITHUB] Önbelleğe yazılamadı (%s): %v", key, err)
+	}
+}
+
+// resolveCommit, bir branch adını veya commit SHA'sını commit SHA'sına çevirir. Branch bulunamazsa
+// ok false döner; bu durumda çağıran taraf isteği ref ile yapar ve GitHub'ın hatasını döndürür.
+func (r *Service) resolveCommit(ref string) (sha string, ok bool, err error) {
+	if isCommitSHA(ref) {
+		return ref, true, nil
+	}
+
+	head, err := r.GetBranchHead(ref)
+	if err != nil {
+		return "", false, err
+	}
+	return head, head != "", nil
+}
+
+// rememberBranchHead, uygulamanın kendi yazdığı commit'ten sonra branch'in yeni son commit'ini önbelleğe yazar
+func (r *Service) rememberBranchHead(branch, sha string) {
+	if r.cache == nil || r.branchTTL <= 0 {
+		return
+	}
+	if err := r.cache.SaveCacheTTL(sha, r.headGroup(), branch, r.branchTTL); err != nil {
+		log.Printf("[GITHUB] Branch önbelleğe yazılamadı (%s): %v", branch, err)
+	}
+}
+
+// forgetBranchHead, son commit'i bilinmeyen bir değişiklikten sonra branch'i önbellekten siler
+func (r *Service) forgetBranchHead(branch string) {
+	if r.cache == nil {
+		return
+	}
+	if err := r.cache.Delete(r.headGroup(), branch); err != nil {
+		log.Printf("[GITHUB] Branch önbellekten silinemedi (%s): %v", branch, err)
+	}
+}
+
+// forgetBranchHeads, reponun tüm branch'lerini önbellekten siler
+func (r *Service) forgetBranchHeads() {
+	if r.cache != nil {
+		r.cache.ClearGroup(r.headGroup())
+	}
+}
+
+func isCommitSHA(ref string) bool {
+	if len(ref) != 40 {
+		return false
+	}
+	for _, ch := range ref {
+		if !('0' <= ch && ch <= '9' || 'a' <= ch && ch <= 'f') {
+			return false
+		}
+	}
+	return true
+}
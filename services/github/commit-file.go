@@ -17,10 +17,22 @@ func (r *Service) CommitFile(branch, path, content, sha, message string) error {
 	// SHA boşsa, bu yeni bir dosyadır (CreateFile). Doluysa, mevcut bir dosyadır (UpdateFile).
 	if sha == "" {
 		opts.SHA = nil // CreateFile için SHA boş olmalı.
-		_, _, err := r.githubClient.Repositories.CreateFile(context.Background(), r.RepoOwner, r.RepoName, path, opts)
+		result, _, err := r.githubClient.Repositories.CreateFile(context.Background(), r.RepoOwner, r.RepoName, path, opts)
+		r.afterFileCommit(branch, result, err)
 		return err
 	}
 
-	_, _, err := r.githubClient.Repositories.UpdateFile(context.Background(), r.RepoOwner, r.RepoName, path, opts)
+	result, _, err := r.githubClient.Repositories.UpdateFile(context.Background(), r.RepoOwner, r.RepoName, path, opts)
+	r.afterFileCommit(branch, result, err)
 	return err
 }
+
+// afterFileCommit, Contents API ile yapılan commit'ten sonra branch'in önbellekteki son commit'ini günceller.
+// Hata olsa bile commit oluşmuş olabileceğinden branch önbellekten silinir.
+func (r *Service) afterFileCommit(branch string, result *github.RepositoryContentResponse, err error) {
+	if err != nil || result == nil || result.Commit.GetSHA() == "" {
+		r.forgetBranchHead(branch)
+		return
+	}
+	r.rememberBranchHead(branch, result.Commit.GetSHA())
+}
@@ -0,0 +1,165 @@
+package GithubService
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/github"
+)
+
+// ErrBranchHeadMismatch, branch'in son commit'i beklenen SHA'dan farklı olduğunda döner.
+// Bu durumda branch başka biri tarafından güncellenmiştir ve istemci içeriği yeniden almalıdır.
+var ErrBranchHeadMismatch = errors.New("branch head has changed")
+
+// FileChange, tek bir commit içinde yazılacak veya silinecek dosyayı temsil eder.
+// SHA doluysa içerik yeniden yüklenmez, mevcut blob kullanılır (taşıma işlemlerinde olduğu gibi).
+type FileChange struct {
+	Path    string
+	Content string
+	SHA     string
+	Delete  bool
+}
+
+// CommitFiles, verilen dosya değişikliklerini Git Data API ile (blob -> tree -> commit -> ref) tek bir commit olarak branch'e yazar.
+// expectedHeadSHA doluysa ve branch'in son commit'i bununla eşleşmiyorsa ErrBranchHeadMismatch döner.
+// Ref güncellemesi force olmadan yapıldığı için arada gelen başka bir commit de aynı hatayla sonuçlanır.
+// Başarılı olursa yeni commit'in SHA'sını döndürür.
+func (r *Service) CommitFiles(branch, expectedHeadSHA string, files []FileChange, message string) (string, error) {
+	ctx := context.Background()
+
+	// 1. Branch'in son commit'ini al ve beklenen SHA ile karşılaştır. Yazma işleminde önbellek kullanılmaz.
+	headSHA, err := r.fetchBranchHead(branch)
+	if err != nil {
+		return "", err
+	}
+	if headSHA == "" {
+		return "", errors.New("branch not found: " + branch)
+	}
+	if expectedHeadSHA != "" && headSHA != expectedHeadSHA {
+		return "", ErrBranchHeadMismatch
+	}
+
+	headCommit, _, err := r.githubClient.Git.GetCommit(ctx, r.RepoOwner, r.RepoName, headSHA)
+	if err != nil {
+		return "", err
+	}
+
+	// 2. Her dosya için blob oluştur; silinecek dosyaları ayrıca işaretle.
+	deleted := make(map[string]bool)
+	entries := make([]github.TreeEntry, 0, len(files))
+	for _, file := range files {
+		if file.Delete {
+			deleted[file.Path] = true
+			continue
+		}
+
+		blobSHA := file.SHA
+		if blobSHA == "" {
+			blob, _, err := r.githubClient.Git.CreateBlob(ctx, r.RepoOwner, r.RepoName, &github.Blob{
+				Content:  github.String(file.Content),
+				Encoding: github.String("utf-8"),
+			})
+			if err != nil {
+				return "", err
+			}
+			blobSHA = blob.GetSHA()
+		}
+
+		entries = append(entries, github.TreeEntry{
+			Path: github.String(file.Path),
+			Mode: github.String("100644"),
+			Type: github.String("blob"),
+			SHA:  github.String(blobSHA),
+		})
+	}
+
+	// 3. Yeni tree'yi oluştur. Silme yoksa mevcut tree temel alınır ve yalnızca değişen dosyalar gönderilir.
+	// API'de bir dosyayı base_tree üzerinden silmek "sha: null" gerektirdiğinden, silme varsa
+	// mevcut tree'deki tüm dosyalar (silinenler hariç) gönderilerek tree baştan oluşturulur.
+	baseTree := headCommit.GetTree().GetSHA()
+	if len(deleted) > 0 {
+		entries, err = r.fullTreeEntries(ctx, baseTree, entries, deleted)
+		if err != nil {
+			return "", err
+		}
+		baseTree = ""
+	}
+
+	tree, _, err := r.githubClient.Git.CreateTree(ctx, r.RepoOwner, r.RepoName, baseTree, entries)
+	if err != nil {
+		return "", err
+	}
+
+	// 4. Yeni tree'yi işaret eden commit'i oluştur.
+	commit, _, err := r.githubClient.Git.CreateCommit(ctx, r.RepoOwner, r.RepoName, &github.Commit{
+		Message: github.String(message),
+		Tree:    tree,
+		Parents: []github.Commit{{SHA: github.String(headSHA)}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// 5. Branch'i yeni commit'e taşı. Fast-forward olmayan güncellemeler GitHub tarafından reddedilir.
+	_, _, err = r.githubClient.Git.UpdateRef(ctx, r.RepoOwner, r.RepoName, &github.Reference{
+		Ref:    github.String("refs/heads/" + branch),
+		Object: &github.GitObject{SHA: commit.SHA},
+	}, false)
+	if err != nil {
+		r.forgetBranchHead(branch)
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusUnprocessableEntity {
+			return "", ErrBranchHeadMismatch
+		}
+		return "", err
+	}
+
+	r.rememberBranchHead(branch, commit.GetSHA())
+	return commit.GetSHA(), nil
+}
+
+// fullTreeEntries, mevcut tree'deki dosyaları değişen dosyalarla birleştirir ve silinen dosyaları çıkarır
+func (r *Service) fullTreeEntries(ctx context.Context, treeSHA string, changed []github.TreeEntry, deleted map[string]bool) ([]github.TreeEntry, error) {
+	current, _, err := r.githubClient.Git.GetTree(ctx, r.RepoOwner, r.RepoName, treeSHA, true)
+	if err != nil {
+		return nil, err
+	}
+	if current.GetTruncated() {
+		return nil, errors.New("repository tree is too large to rewrite in a single commit")
+	}
+
+	changedPaths := make(map[string]bool, len(changed))
+	for _, entry := range changed {
+		changedPaths[entry.GetPath()] = true
+	}
+
+	found := make(map[string]bool, len(deleted))
+	entries := changed
+	for _, entry := range current.Entries {
+		// Klasörler, içindeki dosyaların yollarından yeniden oluşturulur
+		if entry.GetType() == "tree" {
+			continue
+		}
+		if deleted[entry.GetPath()] {
+			found[entry.GetPath()] = true
+			continue
+		}
+		if changedPaths[entry.GetPath()] {
+			continue
+		}
+		entries = append(entries, github.TreeEntry{
+			Path: entry.Path,
+			Mode: entry.Mode,
+			Type: entry.Type,
+			SHA:  entry.SHA,
+		})
+	}
+
+	for path := range deleted {
+		if !found[path] {
+			return nil, errors.New("file not found: " + path)
+		}
+	}
+
+	return entries, nil
+}
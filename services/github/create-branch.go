@@ -17,5 +17,12 @@ func (r *Service) CreateBranch(baseBranch, newBranch string) error {
 	newRefStr := "refs/heads/" + newBranch
 	newRef := &github.Reference{Ref: &newRefStr, Object: &github.GitObject{SHA: baseRef.Object.SHA}}
 	_, _, err = r.githubClient.Git.CreateRef(context.Background(), r.RepoOwner, r.RepoName, newRef)
-	return err
+	if err != nil {
+		r.forgetBranchHead(newBranch)
+		return err
+	}
+
+	// Branch'in olmadığı bilgisi önbellekte kalmasın diye yeni branch'in son commit'i kaydedilir
+	r.rememberBranchHead(newBranch, baseRef.Object.GetSHA())
+	return nil
 }
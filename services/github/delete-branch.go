@@ -4,5 +4,6 @@ import "context"
 
 func (r *Service) DeleteBranch(branch string) error {
 	_, err := r.githubClient.Git.DeleteRef(context.Background(), r.RepoOwner, r.RepoName, "refs/heads/"+branch)
+	r.forgetBranchHead(branch)
 	return err
 }
@@ -0,0 +1,20 @@
+package GithubService
+
+import (
+	"context"
+)
+
+// GetBlob, blob SHA'sı bilinen bir dosyanın ham içeriğini getirir.
+// Blob'lar değişmez olduğundan aynı SHA her zaman aynı içeriği döndürür ve sonuç önbelleğe alınır.
+func (r *Service) GetBlob(sha string) ([]byte, error) {
+	var content []byte
+	err := r.cachedObject(r.objectKey("blob", sha), &content, func() error {
+		raw, _, err := r.githubClient.Git.GetBlobRaw(context.Background(), r.RepoOwner, r.RepoName, sha)
+		content = raw
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
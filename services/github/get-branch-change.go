@@ -2,42 +2,127 @@ package GithubService
 
 import (
 	"context"
+	"fmt"
 )
 
 type Change struct {
-	Path   string
-	Status string
+	Path         string
+	PreviousPath string // Yalnızca "renamed" durumunda dolu
+	Status       string
+	SHA          string // Dosyanın karşılaştırılan branch'teki blob SHA'sı; silinen dosyalarda boş
+	Patch        string // Unified diff; GitHub büyük veya binary dosyalarda patch döndürmez
+}
+
+// compareFile, compare yanıtındaki dosya bilgisidir.
+// Kullanılan go-github sürümü previous_filename alanını desteklemediği için yanıt doğrudan bu yapıya okunur.
+type compareFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename"`
+	Status           string `json:"status"`
+	SHA              string `json:"sha"`
+	Patch            string `json:"patch"`
+}
+
+// BranchComparison, iki branch'in ortak ataya göre karşılaştırmasıdır
+type BranchComparison struct {
+	MergeBaseSHA string
+	AheadBy      int // Karşılaştırılan branch'te olup temel branch'te olmayan commit sayısı
+	BehindBy     int // Temel branch'te olup karşılaştırılan branch'te olmayan commit sayısı
+	Changes      []Change
 }
 
 func (r *Service) GetBranchChanges(baseBranch, compareBranch string) ([]Change, error) {
-	comparison, _, err := r.githubClient.Repositories.CompareCommits(
-		context.Background(),
-		r.RepoOwner,
-		r.RepoName,
-		baseBranch,
-		compareBranch,
-	)
+	_, changes, err := r.GetBranchDiff(baseBranch, compareBranch)
+	return changes, err
+}
+
+// GetBranchDiff, iki branch arasındaki değişiklikleri patch'leriyle birlikte döndürür.
+// Değişiklikler iki branch'in ortak atasına göre hesaplandığından, dosyaların eski halleri
+// dönen merge base commit SHA'sı üzerinden okunmalıdır.
+func (r *Service) GetBranchDiff(baseBranch, compareBranch string) (string, []Change, error) {
+	comparison, err := r.CompareBranches(baseBranch, compareBranch)
+	if err != nil {
+		return "", nil, err
+	}
+	return comparison.MergeBaseSHA, comparison.Changes, nil
+}
+
+// CompareBranches, karşılaştırılan branch'in temel branch'e göre kaç commit ileride/geride olduğunu
+// ve ortak atadan bu yana değişen dosyaları döndürür. İki branch son commit'lerine çevrilir ve sonuç
+// bu commit çiftine göre önbelleğe alınır; branch'ler değişmedikçe karşılaştırma yeniden yapılmaz.
+func (r *Service) CompareBranches(baseBranch, compareBranch string) (*BranchComparison, error) {
+	baseSHA, baseOK, err := r.resolveCommit(baseBranch)
 	if err != nil {
 		return nil, err
 	}
+	compareSHA, compareOK, err := r.resolveCommit(compareBranch)
+	if err != nil {
+		return nil, err
+	}
+	if !baseOK || !compareOK {
+		return r.compareRefs(baseBranch, compareBranch)
+	}
+
+	var comparison *BranchComparison
+	err = r.cachedObject(r.objectKey("compare", baseSHA+"..."+compareSHA), &comparison, func() error {
+		var err error
+		comparison, err = r.compareRefs(baseSHA, compareSHA)
+		return err
+	})
+	return comparison, err
+}
+
+func (r *Service) compareRefs(baseRef, compareRef string) (*BranchComparison, error) {
+	url := fmt.Sprintf("repos/%v/%v/compare/%v...%v", r.RepoOwner, r.RepoName, baseRef, compareRef)
+	req, err := r.githubClient.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comparison struct {
+		MergeBaseCommit struct {
+			SHA string `json:"sha"`
+		} `json:"merge_base_commit"`
+		AheadBy  int           `json:"ahead_by"`
+		BehindBy int           `json:"behind_by"`
+		Files    []compareFile `json:"files"`
+	}
+	if _, err := r.githubClient.Do(context.Background(), req, &comparison); err != nil {
+		return nil, err
+	}
 
 	var changes []Change
 	for _, file := range comparison.Files {
 		status := "modified"
-		switch *file.Status {
+		switch file.Status {
 		case "added":
 			status = "added"
 		case "removed":
 			status = "deleted"
+		case "renamed":
+			status = "renamed"
 		case "modified":
 			status = "modified"
 		}
 
-		changes = append(changes, Change{
-			Path:   *file.Filename,
+		change := Change{
+			Path:   file.Filename,
 			Status: status,
-		})
+			Patch:  file.Patch,
+		}
+		if status == "renamed" {
+			change.PreviousPath = file.PreviousFilename
+		}
+		if status != "deleted" {
+			change.SHA = file.SHA
+		}
+		changes = append(changes, change)
 	}
 
-	return changes, nil
+	return &BranchComparison{
+		MergeBaseSHA: comparison.MergeBaseCommit.SHA,
+		AheadBy:      comparison.AheadBy,
+		BehindBy:     comparison.BehindBy,
+		Changes:      changes,
+	}, nil
 }
@@ -0,0 +1,43 @@
+package GithubService
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/github"
+)
+
+// GetBranchHead, bir branch'in son commit'inin SHA'sını döndürür. Branch yoksa boş string ve nil hata döner.
+// Sonuç (branch'in olmaması dahil) kısa bir süre önbellekte tutulur; uygulamanın kendi commit'leri önbelleği günceller.
+func (r *Service) GetBranchHead(branch string) (string, error) {
+	if r.cache == nil || r.branchTTL <= 0 {
+		return r.fetchBranchHead(branch)
+	}
+
+	var head string
+	if r.cache.Get(r.headGroup(), branch, &head) {
+		return head, nil
+	}
+
+	head, err := r.fetchBranchHead(branch)
+	if err != nil {
+		return "", err
+	}
+	if err := r.cache.SaveCacheTTL(head, r.headGroup(), branch, r.branchTTL); err != nil {
+		log.Printf("[GITHUB] Branch önbelleğe yazılamadı (%s): %v", branch, err)
+	}
+	return head, nil
+}
+
+// fetchBranchHead, branch'in son commit'ini önbelleği kullanmadan GitHub'dan okur
+func (r *Service) fetchBranchHead(branch string) (string, error) {
+	result, _, err := r.githubClient.Repositories.GetBranch(context.Background(), r.RepoOwner, r.RepoName, branch)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return result.GetCommit().GetSHA(), nil
+}
@@ -6,8 +6,44 @@ import (
 	"github.com/google/go-github/github"
 )
 
+// GetFileContent, bir branch'teki veya commit'teki dosyanın içeriğini ve blob SHA'sını döndürür.
+// Branch önce son commit'ine çevrilir; dosyanın o commit'teki blob SHA'sı ve içeriği blob SHA'sına göre önbelleğe alınır.
 func (r *Service) GetFileContent(branch, path string) ([]byte, string, error) {
-	opts := &github.RepositoryContentGetOptions{Ref: branch}
+	commitSHA, ok, err := r.resolveCommit(branch)
+	if err != nil {
+		return nil, "", err
+	}
+	if !ok {
+		return r.fetchFileContent(branch, path)
+	}
+
+	var file cachedFile
+	var content []byte
+	fetched := false
+	err = r.cachedObject(r.objectKey("file", commitSHA, path), &file, func() error {
+		var err error
+		content, file.SHA, err = r.fetchFileContent(commitSHA, path)
+		fetched = err == nil
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Dosya yeni getirildiyse içeriği blob olarak da saklanır; önbellekten geldiyse içerik blob önbelleğinden okunur
+	if fetched {
+		r.saveObject(r.objectKey("blob", file.SHA), content)
+		return content, file.SHA, nil
+	}
+	content, err = r.GetBlob(file.SHA)
+	if err != nil {
+		return nil, "", err
+	}
+	return content, file.SHA, nil
+}
+
+func (r *Service) fetchFileContent(ref, path string) ([]byte, string, error) {
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
 	fileContent, _, _, err := r.githubClient.Repositories.GetContents(context.Background(), r.RepoOwner, r.RepoName, path, opts)
 	if err != nil {
 		return nil, "", err
@@ -2,8 +2,11 @@ package GithubService
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/go-github/github"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/services/cache"
 	"golang.org/x/oauth2"
 )
 
@@ -11,18 +14,52 @@ type Service struct {
 	RepoOwner    string
 	RepoName     string
 	githubClient *github.Client
+	cache        cache.CacheService // nil ise okumalar önbelleğe alınmaz
+	branchTTL    time.Duration      // Branch son commit'lerinin önbellek süresi
+	limiter      *rateLimiter
 }
 
 // NewService, yeni bir Repository örneği oluşturur ve GitHub istemcisini başlatır.
-func NewService(repoOwner, repoName, token string) *Service {
+// İstemcinin tüm istekleri kota takibinden geçer; cacheService verilirse dosya içerikleri, ağaçlar ve
+// karşılaştırmalar commit SHA'larına göre, branch son commit'leri ise kısa bir süreyle önbelleğe alınır.
+func NewService(repoOwner, repoName, token string, cacheService cache.CacheService) *Service {
 	ctx := context.Background()
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
 	tc := oauth2.NewClient(ctx, ts)
+
+	limiter := newRateLimiter(configs.GetGithubRateLimitReserve(), configs.GetGithubRateLimitMaxWait())
+	tc.Transport = &rateLimitTransport{base: tc.Transport, limiter: limiter}
 	client := github.NewClient(tc)
 
 	return &Service{
 		RepoOwner:    repoOwner,
 		RepoName:     repoName,
 		githubClient: client,
+		cache:        cacheService,
+		branchTTL:    configs.GetGithubBranchCacheTTL(),
+		limiter:      limiter,
+	}
+}
+
+// WithRepository, aynı GitHub istemcisini, kimlik bilgisini, önbelleği ve kota takibini kullanarak başka bir repo için servis döndürür.
+// Boş değerler mevcut servisin sahibini ve repo adını kullanır.
+func (r *Service) WithRepository(repoOwner, repoName string) *Service {
+	if repoOwner == "" {
+		repoOwner = r.RepoOwner
+	}
+	if repoName == "" {
+		repoName = r.RepoName
+	}
+	if repoOwner == r.RepoOwner && repoName == r.RepoName {
+		return r
+	}
+
+	return &Service{
+		RepoOwner:    repoOwner,
+		RepoName:     repoName,
+		githubClient: r.githubClient,
+		cache:        r.cache,
+		branchTTL:    r.branchTTL,
+		limiter:      r.limiter,
 	}
 }
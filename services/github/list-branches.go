@@ -0,0 +1,29 @@
+package GithubService
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+// ListBranches, adı prefix ile başlayan branch'leri döndürür. Eşleşen branch yoksa boş liste döner.
+func (r *Service) ListBranches(prefix string) ([]string, error) {
+	url := fmt.Sprintf("repos/%s/%s/git/matching-refs/heads/%s", r.RepoOwner, r.RepoName, prefix)
+	req, err := r.githubClient.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []*github.Reference
+	if _, err := r.githubClient.Do(context.Background(), req, &refs); err != nil {
+		return nil, err
+	}
+
+	branches := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		branches = append(branches, strings.TrimPrefix(ref.GetRef(), "refs/heads/"))
+	}
+	return branches, nil
+}
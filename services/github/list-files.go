@@ -0,0 +1,61 @@
+package GithubService
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// TreeFile, bir branch'teki dosya ağacının tek bir dosyasını temsil eder
+type TreeFile struct {
+	Path string
+	SHA  string
+	Size int
+}
+
+// ListFiles, bir branch'te veya commit'te verilen klasör altındaki tüm dosyaları (alt klasörler dahil) listeler.
+// Branch son commit'ine çevrilir ve liste commit SHA'sına göre önbelleğe alınır. GitHub ağacı kesilmiş
+// döndürürse eksik bir liste yerine hata döner.
+func (r *Service) ListFiles(branch, dirPath string) ([]TreeFile, error) {
+	commitSHA, ok, err := r.resolveCommit(branch)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.fetchFiles(branch, dirPath)
+	}
+
+	var files []TreeFile
+	err = r.cachedObject(r.objectKey("tree", commitSHA, dirPath), &files, func() error {
+		var err error
+		files, err = r.fetchFiles(commitSHA, dirPath)
+		return err
+	})
+	return files, err
+}
+
+func (r *Service) fetchFiles(ref, dirPath string) ([]TreeFile, error) {
+	tree, _, err := r.githubClient.Git.GetTree(context.Background(), r.RepoOwner, r.RepoName, ref, true)
+	if err != nil {
+		return nil, err
+	}
+	if tree.GetTruncated() {
+		return nil, errors.New("repository tree is too large to list in a single request")
+	}
+
+	prefix := strings.TrimSuffix(dirPath, "/") + "/"
+
+	var files []TreeFile
+	for _, entry := range tree.Entries {
+		if entry.GetType() != "blob" || !strings.HasPrefix(entry.GetPath(), prefix) {
+			continue
+		}
+		files = append(files, TreeFile{
+			Path: entry.GetPath(),
+			SHA:  entry.GetSHA(),
+			Size: entry.GetSize(),
+		})
+	}
+
+	return files, nil
+}
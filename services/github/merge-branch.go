@@ -0,0 +1,36 @@
+package GithubService
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/github"
+)
+
+// ErrMergeConflict, iki branch çakışma nedeniyle otomatik olarak birleştirilemediğinde döner
+var ErrMergeConflict = errors.New("merge conflict")
+
+// MergeBranch, head branch'i base branch'e birleştiren bir merge commit'i oluşturur (ör. main'i taslağa almak için).
+// base zaten güncelse boş SHA döner. Çakışma varsa hiçbir değişiklik yapılmaz ve ErrMergeConflict döner.
+func (r *Service) MergeBranch(base, head, message string) (string, error) {
+	commit, resp, err := r.githubClient.Repositories.Merge(context.Background(), r.RepoOwner, r.RepoName, &github.RepositoryMergeRequest{
+		Base:          github.String(base),
+		Head:          github.String(head),
+		CommitMessage: github.String(message),
+	})
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusConflict {
+			return "", ErrMergeConflict
+		}
+		r.forgetBranchHead(base)
+		return "", err
+	}
+
+	// 204: Birleştirilecek bir şey yok, base zaten head'i içeriyor
+	if resp.StatusCode == http.StatusNoContent {
+		return "", nil
+	}
+	r.rememberBranchHead(base, commit.GetSHA())
+	return commit.GetSHA(), nil
+}
@@ -1,34 +1,28 @@
 package GithubService
 
 import (
-	"context"
 	"fmt"
-
-	"github.com/google/go-github/github"
+	"log"
 )
 
 func (r *Service) PublishBranchToMain(branch string) (report string, err error) {
 	// 1. Pull Request oluştur.
 	prTitle := fmt.Sprintf("feat: Publish changes from %s", branch)
 	prBody := "This pull request was automatically generated to publish changes."
-	newPR := &github.NewPullRequest{
-		Title: &prTitle,
-		Head:  &branch,
-		Base:  github.String("main"),
-		Body:  &prBody,
-	}
-	pr, _, err := r.githubClient.PullRequests.Create(context.Background(), r.RepoOwner, r.RepoName, newPR)
+	number, _, err := r.CreatePullRequest("main", branch, prTitle, prBody)
 	if err != nil {
 		return "Pull request oluşturulamadı", err
 	}
 
 	// 2. Oluşturulan Pull Request'i birleştir (merge).
-	mergeResult, _, err := r.githubClient.PullRequests.Merge(context.Background(), r.RepoOwner, r.RepoName, *pr.Number, "Automatic merge", nil)
+	result, err := r.MergePullRequest(number, "", "Automatic merge")
 	if err != nil {
-		// Çakışma varsa, PR'ı kapatıp hata dönebiliriz.
-		// r.githubClient.PullRequests.Edit(...) ile PR'ı kapat
+		// Birleştirilemeyen PR açık bırakılmaz; taslak main ile senkronize edildikten sonra yeniden yayınlanabilir.
+		if closeErr := r.ClosePullRequest(number); closeErr != nil {
+			log.Printf("[GITHUB] Birleştirilemeyen PR kapatılamadı (#%d): %v", number, closeErr)
+		}
 		return "Pull request birleştirilemedi, muhtemelen çakışma var.", err
 	}
 
-	return *mergeResult.Message, nil
+	return result.Message, nil
 }
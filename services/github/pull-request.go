@@ -0,0 +1,75 @@
+package GithubService
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/github"
+)
+
+// CreatePullRequest, branch'ten temel branch'e (ör. main) bir Pull Request açar ve numarasıyla adresini döndürür
+func (r *Service) CreatePullRequest(base, branch, title, body string) (int, string, error) {
+	pr, _, err := r.githubClient.PullRequests.Create(context.Background(), r.RepoOwner, r.RepoName, &github.NewPullRequest{
+		Title: github.String(title),
+		Head:  github.String(branch),
+		Base:  github.String(base),
+		Body:  github.String(body),
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	return pr.GetNumber(), pr.GetHTMLURL(), nil
+}
+
+// MergeResult, birleştirilen bir Pull Request'in sonucudur
+type MergeResult struct {
+	SHA     string // main'de oluşan merge commit'inin SHA'sı
+	Message string // GitHub'ın birleştirme mesajı
+}
+
+// MergePullRequest, Pull Request'i birleştirir ve oluşan merge commit'ini döndürür.
+// expectedHeadSHA doluysa ve PR'ın son commit'i farklıysa birleştirme yapılmaz, ErrBranchHeadMismatch döner.
+func (r *Service) MergePullRequest(number int, expectedHeadSHA, message string) (*MergeResult, error) {
+	mergeResult, _, err := r.githubClient.PullRequests.Merge(context.Background(), r.RepoOwner, r.RepoName, number, message, &github.PullRequestOptions{
+		SHA: expectedHeadSHA,
+	})
+	// PR'ın temel branch'i burada bilinmediğinden reponun tüm branch'leri önbellekten silinir
+	r.forgetBranchHeads()
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusConflict && expectedHeadSHA != "" {
+			return nil, ErrBranchHeadMismatch
+		}
+		return nil, err
+	}
+	return &MergeResult{SHA: mergeResult.GetSHA(), Message: mergeResult.GetMessage()}, nil
+}
+
+// GetCommitParent, bir commit'in ilk ebeveyninin SHA'sını döndürür.
+// Bir merge commit'i için bu, birleştirmeden önceki main'in son commit'idir.
+func (r *Service) GetCommitParent(sha string) (string, error) {
+	commit, _, err := r.githubClient.Git.GetCommit(context.Background(), r.RepoOwner, r.RepoName, sha)
+	if err != nil {
+		return "", err
+	}
+	if len(commit.Parents) == 0 {
+		return "", errors.New("commit has no parent: " + sha)
+	}
+	return commit.Parents[0].GetSHA(), nil
+}
+
+// ClosePullRequest, Pull Request'i birleştirmeden kapatır
+func (r *Service) ClosePullRequest(number int) error {
+	_, _, err := r.githubClient.PullRequests.Edit(context.Background(), r.RepoOwner, r.RepoName, number, &github.PullRequest{
+		State: github.String("closed"),
+	})
+	return err
+}
+
+// CommentOnPullRequest, Pull Request'e yorum ekler
+func (r *Service) CommentOnPullRequest(number int, body string) error {
+	_, _, err := r.githubClient.Issues.CreateComment(context.Background(), r.RepoOwner, r.RepoName, number, &github.IssueComment{
+		Body: github.String(body),
+	})
+	return err
+}
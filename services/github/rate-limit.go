@@ -0,0 +1,238 @@
+package GithubService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// ErrRateLimited, GitHub API kotası dolduğunda ve sıfırlanma beklenebilecek süreden uzaksa döner
+var ErrRateLimited = errors.New("github rate limit exceeded")
+
+// RateLimitStatus, GitHub'ın son yanıttaki X-RateLimit-* başlıklarından okunan kota durumudur
+type RateLimitStatus struct {
+	Limit      int        `json:"limit"`
+	Remaining  int        `json:"remaining"`
+	Used       int        `json:"used"`
+	Resource   string     `json:"resource"`
+	Reset      time.Time  `json:"reset"`
+	RetryAfter *time.Time `json:"retryAfter,omitempty"` // 403/429 yanıtlarındaki Retry-After süresinin bittiği an
+	Reserve    int        `json:"reserve"`              // Bu sayının altında istekler sıraya alınır
+	Throttled  bool       `json:"throttled"`            // İstekler şu anda sıraya alınıp yayılarak gönderiliyor
+	Queued     int64      `json:"queued"`               // Sırada bekleyen istek sayısı
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`  // Henüz istek yapılmadıysa boş
+}
+
+// rateLimiter, servisin tüm kopyalarının paylaştığı kota takibidir. Aynı token ile yapılan istekler aynı kotayı
+// tükettiği için WithRepository ile oluşturulan servisler de aynı takipçiyi kullanır.
+type rateLimiter struct {
+	mu         sync.Mutex
+	status     RateLimitStatus
+	retryAfter time.Time
+	updatedAt  time.Time
+	warnedFor  time.Time // Aynı kota penceresi için uyarının tekrar loglanmaması için
+
+	reserve int
+	maxWait time.Duration
+	slot    chan struct{} // Kotaya yaklaşıldığında istekler bu kanal üzerinden tek tek gönderilir
+	queued  atomic.Int64
+}
+
+func newRateLimiter(reserve int, maxWait time.Duration) *rateLimiter {
+	return &rateLimiter{
+		reserve: reserve,
+		maxWait: maxWait,
+		slot:    make(chan struct{}, 1),
+	}
+}
+
+// RateLimit, GitHub API kotasının bilinen son durumunu döndürür
+func (r *Service) RateLimit() RateLimitStatus {
+	return r.limiter.snapshot()
+}
+
+// RefreshRateLimit, kota durumunu GitHub'ın /rate_limit adresinden okur. Bu istek kotadan düşmez.
+// Kota dolduğu bilindiğinde istemci isteği göndermez; bu durumda bilinen son durum döner.
+func (r *Service) RefreshRateLimit() (RateLimitStatus, error) {
+	limits, _, err := r.githubClient.RateLimits(context.Background())
+	if _, exhausted := err.(*github.RateLimitError); exhausted {
+		return r.RateLimit(), nil
+	}
+	if err != nil {
+		return r.RateLimit(), err
+	}
+	if core := limits.GetCore(); core != nil {
+		r.limiter.mu.Lock()
+		r.limiter.applyLocked(core.Limit, core.Remaining, core.Reset.Time, "core", time.Now())
+		r.limiter.mu.Unlock()
+	}
+	return r.RateLimit(), nil
+}
+
+func (l *rateLimiter) snapshot() RateLimitStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	status := l.status
+	status.Reserve = l.reserve
+	status.Throttled = l.throttledLocked(time.Now())
+	status.Queued = l.queued.Load()
+	if !l.retryAfter.IsZero() && l.retryAfter.After(time.Now()) {
+		retryAfter := l.retryAfter
+		status.RetryAfter = &retryAfter
+	}
+	if !l.updatedAt.IsZero() {
+		updatedAt := l.updatedAt
+		status.UpdatedAt = &updatedAt
+	}
+	return status
+}
+
+// throttledLocked, kotanın yedek sınırın altına inip inmediğini döndürür. Kilit tutulurken çağrılmalıdır.
+func (l *rateLimiter) throttledLocked(now time.Time) bool {
+	if l.retryAfter.After(now) {
+		return true
+	}
+	return l.status.Limit > 0 && l.status.Reset.After(now) && l.status.Remaining < l.reserve
+}
+
+// wait, isteği göndermeden önce kota durumuna göre bekler. Kota bolken hemen döner; yedek sınırın altında
+// istekler sıraya alınır ve kalan kota sıfırlanma zamanına kadar eşit aralıklarla harcanır.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	throttled := l.throttledLocked(time.Now())
+	l.mu.Unlock()
+	if !throttled {
+		return nil
+	}
+
+	l.queued.Add(1)
+	defer l.queued.Add(-1)
+
+	select {
+	case l.slot <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-l.slot }()
+
+	delay, err := l.nextDelay()
+	if err != nil || delay <= 0 {
+		return err
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// nextDelay, sıradaki isteğin ne kadar bekleyeceğini hesaplar. Beklenmesi gereken süre üst sınırı aşıyorsa ErrRateLimited döner.
+func (l *rateLimiter) nextDelay() (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.retryAfter.After(now) {
+		wait := l.retryAfter.Sub(now)
+		if wait > l.maxWait {
+			return 0, fmt.Errorf("%w: retry after %s", ErrRateLimited, l.retryAfter.Format(time.RFC3339))
+		}
+		return wait, nil
+	}
+
+	untilReset := l.status.Reset.Sub(now)
+	if untilReset <= 0 {
+		return 0, nil // Pencere sıfırlandı; bir sonraki yanıt yeni kotayı getirir
+	}
+
+	if l.status.Remaining <= 0 {
+		if untilReset > l.maxWait {
+			return 0, fmt.Errorf("%w: resets at %s", ErrRateLimited, l.status.Reset.Format(time.RFC3339))
+		}
+		return untilReset, nil
+	}
+
+	// Yanıt gelmeden sıradaki istekler aynı kotayı görmesin diye kalan istek sayısı önceden düşürülür
+	delay := min(untilReset/time.Duration(l.status.Remaining+1), l.maxWait)
+	l.status.Remaining--
+	return delay, nil
+}
+
+// update, yanıt başlıklarından kota durumunu günceller
+func (l *rateLimiter) update(resp *http.Response) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			l.retryAfter = now.Add(time.Duration(seconds) * time.Second)
+			log.Printf("[GITHUB] İkincil kota sınırına takıldı, istekler %d saniye bekletilecek", seconds)
+		}
+	}
+
+	limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	// Yalnızca REST API'nin ana kotası izlenir; arama gibi ayrı kotalar servis tarafından kullanılmaz
+	resource := resp.Header.Get("X-RateLimit-Resource")
+	if resource != "" && resource != "core" {
+		return
+	}
+
+	remaining, _ := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	resetUnix, _ := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	l.applyLocked(limit, remaining, time.Unix(resetUnix, 0), resource, now)
+}
+
+// applyLocked, kota durumunu günceller ve kota azaldığında pencere başına bir kez uyarı loglar. Kilit tutulurken çağrılmalıdır.
+func (l *rateLimiter) applyLocked(limit, remaining int, reset time.Time, resource string, now time.Time) {
+	l.status = RateLimitStatus{
+		Limit:     limit,
+		Remaining: remaining,
+		Used:      limit - remaining,
+		Resource:  resource,
+		Reset:     reset,
+	}
+	l.updatedAt = now
+
+	if remaining < l.reserve && !l.warnedFor.Equal(l.status.Reset) {
+		l.warnedFor = l.status.Reset
+		log.Printf("[GITHUB] API kotası azaldı: %d/%d istek kaldı, istekler %s zamanına kadar yayılarak gönderilecek",
+			remaining, limit, l.status.Reset.Format(time.RFC3339))
+	}
+}
+
+// rateLimitTransport, GitHub istemcisinin tüm isteklerini kota takibinden geçirir
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	t.limiter.update(resp)
+	return resp, nil
+}
@@ -0,0 +1,32 @@
+package R2Service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// CopyObject bir nesneyi bucket içinde yeni bir anahtara kopyalar
+func (r *Service) CopyObject(ctx context.Context, sourceKey, destinationKey string) error {
+	// CopySource "bucket/key" formatında ve URL-encoded olmalı
+	segments := strings.Split(sourceKey, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	copySource := r.bucketName + "/" + strings.Join(segments, "/")
+
+	_, err := r.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(r.bucketName),
+		Key:        aws.String(destinationKey),
+		CopySource: aws.String(copySource),
+	})
+	if err != nil {
+		return fmt.Errorf("nesne kopyalanamadı (%s -> %s): %w", sourceKey, destinationKey, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package R2Service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// HeadObject bir nesnenin metadata bilgilerini getirir. Nesne yoksa nil döner.
+func (r *Service) HeadObject(ctx context.Context, objectKey string) (*types.ObjectInfo, error) {
+	result, err := r.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:       aws.String(r.bucketName),
+		Key:          aws.String(objectKey),
+		ChecksumMode: s3types.ChecksumModeEnabled,
+	})
+	if err != nil {
+		var notFound *s3types.NotFound
+		var noSuchKey *s3types.NoSuchKey
+		if errors.As(err, &notFound) || errors.As(err, &noSuchKey) {
+			return nil, nil // Nesne bulunamadı
+		}
+		return nil, fmt.Errorf("nesne bilgisi alınamadı (key: %s): %w", objectKey, err)
+	}
+
+	return &types.ObjectInfo{
+		Key:            objectKey,
+		SizeInBytes:    aws.ToInt64(result.ContentLength),
+		ContentType:    aws.ToString(result.ContentType),
+		ETag:           aws.ToString(result.ETag),
+		ChecksumSHA256: utils.Base64ToHex(aws.ToString(result.ChecksumSHA256)),
+		LastModified:   aws.ToTime(result.LastModified),
+	}, nil
+}
+
+// GetObject bir nesnenin içeriğini akış olarak döndürür. Okuyucunun kapatılması çağıranın sorumluluğundadır.
+func (r *Service) GetObject(ctx context.Context, objectKey string) (io.ReadCloser, error) {
+	result, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("nesne okunamadı (key: %s): %w", objectKey, err)
+	}
+
+	return result.Body, nil
+}
@@ -18,9 +18,19 @@ type Service struct {
 }
 
 func NewService(accountID, accessKeyID, accessKeySecret, bucketName, folderName, publicURLBase, endpoint string) *Service {
+	return NewS3Service(endpoint, "auto", accessKeyID, accessKeySecret, bucketName, folderName, publicURLBase)
+}
+
+// NewS3Service, R2 dışındaki S3-uyumlu servisler (MinIO, AWS S3 vb.) için bir Service oluşturur.
+// R2 ile aynı API'yi kullandığından tüm fonksiyonlar her iki ortamda da çalışır.
+func NewS3Service(endpoint, region, accessKeyID, accessKeySecret, bucketName, folderName, publicURLBase string) *Service {
+	if region == "" {
+		region = "us-east-1"
+	}
+
 	// SDK konfigürasyonu oluştur
 	cfg, err := config.LoadDefaultConfig(context.Background(),
-		config.WithRegion("auto"),
+		config.WithRegion(region),
 		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
 			accessKeyID,
 			accessKeySecret,
@@ -28,7 +38,7 @@ func NewService(accountID, accessKeyID, accessKeySecret, bucketName, folderName,
 		)),
 	)
 	if err != nil {
-		panic(fmt.Sprintf("R2 configuration error: %s", err))
+		panic(fmt.Sprintf("S3 configuration error: %s", err))
 	}
 
 	// S3 istemcisini özel endpoint ile oluştur
@@ -0,0 +1,38 @@
+package R2Service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/okanay/backend-template/types"
+)
+
+// ListObjects verilen önekle başlayan tüm nesneleri listeler
+func (r *Service) ListObjects(ctx context.Context, prefix string) ([]types.ObjectInfo, error) {
+	var objects []types.ObjectInfo
+
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucketName),
+		Prefix: aws.String(prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("nesneler listelenemedi (prefix: %s): %w", prefix, err)
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, types.ObjectInfo{
+				Key:          aws.ToString(object.Key),
+				SizeInBytes:  aws.ToInt64(object.Size),
+				ETag:         aws.ToString(object.ETag),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
+}
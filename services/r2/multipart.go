@@ -0,0 +1,134 @@
+package R2Service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// CreateMultipartUpload büyük dosyalar için R2 üzerinde çok parçalı bir yükleme başlatır
+func (r *Service) CreateMultipartUpload(ctx context.Context, input types.PresignURLInput) (*types.MultipartUploadOutput, error) {
+	objectPath := utils.BuildObjectKey(r.folderName, input.Filename, input.FileCategory, input.Visibility)
+
+	result, err := r.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(r.bucketName),
+		Key:         aws.String(objectPath),
+		ContentType: aws.String(input.ContentType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("multipart upload başlatılamadı: %w", err)
+	}
+
+	return &types.MultipartUploadOutput{
+		UploadID:  aws.ToString(result.UploadId),
+		ObjectKey: objectPath,
+		UploadURL: r.PublicURL(objectPath),
+	}, nil
+}
+
+// PresignUploadParts verilen parça numaraları için presigned UploadPart URL'leri oluşturur
+func (r *Service) PresignUploadParts(ctx context.Context, objectKey, uploadID string, partNumbers []int32) ([]types.MultipartPartURL, time.Time, error) {
+	presignClient := s3.NewPresignClient(r.client)
+	expiresAt := time.Now().Add(configs.FILE_MULTIPART_URL_DURATION)
+
+	parts := make([]types.MultipartPartURL, 0, len(partNumbers))
+	for _, partNumber := range partNumbers {
+		request, err := presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(r.bucketName),
+			Key:        aws.String(objectKey),
+			UploadId:   aws.String(uploadID),
+			PartNumber: aws.Int32(partNumber),
+		}, func(opts *s3.PresignOptions) {
+			opts.Expires = configs.FILE_MULTIPART_URL_DURATION
+		})
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("parça URL'si oluşturulamadı (part: %d): %w", partNumber, err)
+		}
+
+		parts = append(parts, types.MultipartPartURL{
+			PartNumber: partNumber,
+			URL:        request.URL,
+		})
+	}
+
+	return parts, expiresAt, nil
+}
+
+// CompleteMultipartUpload yüklenen parçaları birleştirerek nesneyi oluşturur
+func (r *Service) CompleteMultipartUpload(ctx context.Context, objectKey, uploadID string, parts []types.CompletedPart) error {
+	completedParts := make([]s3types.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completedParts = append(completedParts, s3types.CompletedPart{
+			PartNumber: aws.Int32(part.PartNumber),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+
+	_, err := r.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(r.bucketName),
+		Key:             aws.String(objectKey),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: completedParts},
+	})
+	if err != nil {
+		return fmt.Errorf("multipart upload tamamlanamadı (key: %s): %w", objectKey, err)
+	}
+
+	return nil
+}
+
+// AbortMultipartUpload yarım kalan bir çok parçalı yüklemeyi iptal eder ve parçaları siler
+func (r *Service) AbortMultipartUpload(ctx context.Context, objectKey, uploadID string) error {
+	_, err := r.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(r.bucketName),
+		Key:      aws.String(objectKey),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil {
+		return fmt.Errorf("multipart upload iptal edilemedi (key: %s): %w", objectKey, err)
+	}
+
+	return nil
+}
+
+// AbortStaleMultipartUploads belirtilen zamandan önce başlatılmış ve hâlâ tamamlanmamış
+// tüm çok parçalı yüklemeleri iptal eder. İptal edilen yükleme sayısını döndürür.
+func (r *Service) AbortStaleMultipartUploads(ctx context.Context, initiatedBefore time.Time) (int, error) {
+	aborted := 0
+	input := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(r.bucketName),
+		Prefix: aws.String(r.folderName),
+	}
+
+	for {
+		result, err := r.client.ListMultipartUploads(ctx, input)
+		if err != nil {
+			return aborted, fmt.Errorf("multipart upload listesi alınamadı: %w", err)
+		}
+
+		for _, upload := range result.Uploads {
+			if upload.Initiated == nil || !upload.Initiated.Before(initiatedBefore) {
+				continue
+			}
+			if err := r.AbortMultipartUpload(ctx, aws.ToString(upload.Key), aws.ToString(upload.UploadId)); err != nil {
+				return aborted, err
+			}
+			aborted++
+		}
+
+		if !aws.ToBool(result.IsTruncated) {
+			break
+		}
+		input.KeyMarker = result.NextKeyMarker
+		input.UploadIdMarker = result.NextUploadIdMarker
+	}
+
+	return aborted, nil
+}
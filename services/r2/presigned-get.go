@@ -0,0 +1,34 @@
+package R2Service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/okanay/backend-template/utils"
+)
+
+// GeneratePresignedGetURL bir nesneyi indirmek için süresi kısıtlı bir URL oluşturur.
+// downloadFilename verilirse, yanıt Content-Disposition: attachment başlığıyla döner.
+func (r *Service) GeneratePresignedGetURL(ctx context.Context, objectKey string, expires time.Duration, downloadFilename string) (string, error) {
+	presignClient := s3.NewPresignClient(r.client)
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(objectKey),
+	}
+	if downloadFilename != "" {
+		input.ResponseContentDisposition = aws.String(utils.ContentDisposition(downloadFilename))
+	}
+
+	request, err := presignClient.PresignGetObject(ctx, input, func(opts *s3.PresignOptions) {
+		opts.Expires = expires
+	})
+	if err != nil {
+		return "", fmt.Errorf("indirme URL'si oluşturulamadı (key: %s): %w", objectKey, err)
+	}
+
+	return request.URL, nil
+}
@@ -0,0 +1,109 @@
+package R2Service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// UploadObject içeriği sunucu üzerinden R2'ye aktarır.
+// Gövdenin tamamı belleğe alınmaz: tek parçaya sığan içerik doğrudan PutObject ile,
+// daha büyük içerik ise FILE_MULTIPART_PART_SIZE büyüklüğündeki parçalar halinde multipart upload ile yüklenir.
+func (r *Service) UploadObject(ctx context.Context, input types.PresignURLInput, body io.Reader) (*types.UploadObjectOutput, error) {
+	objectPath := utils.BuildObjectKey(r.folderName, input.Filename, input.FileCategory, input.Visibility)
+
+	buffer := make([]byte, configs.FILE_MULTIPART_PART_SIZE)
+	n, err := io.ReadFull(body, buffer)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("dosya okunamadı: %w", err)
+	}
+
+	var size int64
+	if n < len(buffer) {
+		// İçerik tek parçaya sığıyor
+		_, err = r.client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        aws.String(r.bucketName),
+			Key:           aws.String(objectPath),
+			ContentType:   aws.String(input.ContentType),
+			ContentLength: aws.Int64(int64(n)),
+			Body:          bytes.NewReader(buffer[:n]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("nesne yüklenemedi: %w", err)
+		}
+		size = int64(n)
+	} else {
+		size, err = r.uploadMultipart(ctx, objectPath, input.ContentType, buffer, body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.UploadObjectOutput{
+		ObjectKey:   objectPath,
+		UploadURL:   r.PublicURL(objectPath),
+		SizeInBytes: size,
+	}, nil
+}
+
+// uploadMultipart ilk parçası okunmuş içeriği parça parça yükler. Hata olursa yükleme iptal edilir.
+func (r *Service) uploadMultipart(ctx context.Context, objectKey, contentType string, buffer []byte, body io.Reader) (int64, error) {
+	created, err := r.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(r.bucketName),
+		Key:         aws.String(objectKey),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("multipart upload başlatılamadı: %w", err)
+	}
+	uploadID := aws.ToString(created.UploadId)
+
+	var size int64
+	var parts []s3types.CompletedPart
+	n := len(buffer)
+	for partNumber := int32(1); n > 0; partNumber++ {
+		result, err := r.client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(r.bucketName),
+			Key:           aws.String(objectKey),
+			UploadId:      aws.String(uploadID),
+			PartNumber:    aws.Int32(partNumber),
+			ContentLength: aws.Int64(int64(n)),
+			Body:          bytes.NewReader(buffer[:n]),
+		})
+		if err != nil {
+			r.AbortMultipartUpload(context.Background(), objectKey, uploadID)
+			return 0, fmt.Errorf("parça yüklenemedi (part: %d): %w", partNumber, err)
+		}
+
+		parts = append(parts, s3types.CompletedPart{PartNumber: aws.Int32(partNumber), ETag: result.ETag})
+		size += int64(n)
+
+		n, err = io.ReadFull(body, buffer)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			r.AbortMultipartUpload(context.Background(), objectKey, uploadID)
+			return 0, fmt.Errorf("dosya okunamadı: %w", err)
+		}
+	}
+
+	_, err = r.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(r.bucketName),
+		Key:             aws.String(objectKey),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		r.AbortMultipartUpload(context.Background(), objectKey, uploadID)
+		return 0, fmt.Errorf("multipart upload tamamlanamadı: %w", err)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,105 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/okanay/backend-template/types"
+)
+
+// clamdChunkSize, INSTREAM komutunda gönderilen parça büyüklüğü
+const clamdChunkSize = 64 << 10
+
+// ClamAVScanner, clamd daemon'una INSTREAM protokolü ile bağlanarak tarama yapar.
+// İçerik parça parça gönderildiğinden dosyanın tamamı belleğe alınmaz.
+type ClamAVScanner struct {
+	network string
+	address string
+	dialer  net.Dialer
+}
+
+// NewClamAVScanner verilen adres için bir tarayıcı oluşturur.
+// Adres "tcp://host:port" veya "unix:///var/run/clamav/clamd.ctl" biçiminde olabilir; şemasız adresler TCP kabul edilir.
+func NewClamAVScanner(address string) *ClamAVScanner {
+	network := "tcp"
+	if scheme, rest, ok := strings.Cut(address, "://"); ok {
+		network, address = scheme, rest
+	}
+
+	return &ClamAVScanner{
+		network: network,
+		address: address,
+		dialer:  net.Dialer{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *ClamAVScanner) Name() string {
+	return "clamav"
+}
+
+func (s *ClamAVScanner) Scan(ctx context.Context, content io.Reader) (*types.ScanResult, error) {
+	conn, err := s.dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("clamd bağlantısı kurulamadı: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, fmt.Errorf("clamd komutu gönderilemedi: %w", err)
+	}
+
+	// Her parça 4 byte'lık (big-endian) uzunluk ile gönderilir, sıfır uzunluklu parça akışı bitirir
+	buffer := make([]byte, 4+clamdChunkSize)
+	for {
+		n, readErr := io.ReadFull(content, buffer[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buffer[:4], uint32(n))
+			if _, err := conn.Write(buffer[:4+n]); err != nil {
+				// clamd boyut sınırı aşıldığında bağlantıyı kapatır; yanıtı okumaya çalış
+				break
+			}
+		}
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, fmt.Errorf("taranacak içerik okunamadı: %w", readErr)
+		}
+	}
+	conn.Write([]byte{0, 0, 0, 0})
+
+	reply, err := bufio.NewReader(conn).ReadString('\x00')
+	if err != nil && reply == "" {
+		return nil, fmt.Errorf("clamd yanıtı okunamadı: %w", err)
+	}
+
+	return parseClamdReply(reply)
+}
+
+// parseClamdReply, "stream: OK" veya "stream: <imza> FOUND" yanıtını çözümler
+func parseClamdReply(reply string) (*types.ScanResult, error) {
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+	reply = strings.TrimPrefix(reply, "stream: ")
+
+	switch {
+	case reply == "OK":
+		return &types.ScanResult{}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return &types.ScanResult{Infected: true, Signature: strings.TrimSuffix(reply, " FOUND")}, nil
+	case strings.Contains(reply, "size limit exceeded"):
+		return nil, fmt.Errorf("%w: %s", ErrContentTooLarge, reply)
+	default:
+		return nil, fmt.Errorf("clamd hatası: %s", reply)
+	}
+}
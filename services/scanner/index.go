@@ -0,0 +1,52 @@
+// services/scanner/index.go
+package scanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/okanay/backend-template/types"
+)
+
+// ErrContentTooLarge, içerik tarayıcının kabul ettiği en büyük boyutu aştığında döner. Bu hata tekrar denemeyle düzelmez.
+var ErrContentTooLarge = errors.New("content exceeds scanner size limit")
+
+// Scanner, yüklenen dosyaları zararlı yazılıma karşı tarayan implementasyonlar için ortak arayüz
+type Scanner interface {
+	// Name, tarama kayıtlarında kullanılan tarayıcı adıdır (ör. "clamav")
+	Name() string
+	// Scan içeriği sonuna kadar okur ve tarama sonucunu döndürür. Hata, içeriğin taranamadığı anlamına gelir.
+	Scan(ctx context.Context, content io.Reader) (*types.ScanResult, error)
+}
+
+// Derleme zamanında implementasyonların arayüzü karşıladığını doğrula
+var (
+	_ Scanner = (*ClamAVScanner)(nil)
+	_ Scanner = (*FakeScanner)(nil)
+)
+
+// NewScannerService, ortam değişkenlerine göre uygun tarayıcıyı döndürür.
+// SCANNER_DRIVER: "clamav", "fake" veya boş. Boşsa nil döner ve dosyalar taranmadan aktif olur.
+func NewScannerService() Scanner {
+	switch os.Getenv("SCANNER_DRIVER") {
+	case "clamav":
+		address := os.Getenv("CLAMAV_ADDRESS")
+		if address == "" {
+			address = "tcp://localhost:3310"
+		}
+		fmt.Println("🛡️ [SCANNER] : Starting ClamAV scanner")
+		fmt.Printf("🔗 clamd address : %s\n", address)
+		return NewClamAVScanner(address)
+
+	case "fake":
+		fmt.Println("🛡️ [SCANNER] : Starting fake scanner (EICAR test imzası tespit edilir)")
+		return NewFakeScanner()
+
+	default:
+		fmt.Println("⚠️ [SCANNER] : Scanner disabled, uploads are not scanned")
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+// services/storage/index.go
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	R2Service "github.com/okanay/backend-template/services/r2"
+	"github.com/okanay/backend-template/types"
+)
+
+// Storage, tüm dosya depolama implementasyonları için ortak arayüz
+type Storage interface {
+	// Tek parçalı yükleme ve indirme
+	GeneratePresignedURL(ctx context.Context, input types.PresignURLInput) (*types.PresignedURLOutput, error)
+	GeneratePresignedGetURL(ctx context.Context, objectKey string, expires time.Duration, downloadFilename string) (string, error)
+	UploadObject(ctx context.Context, input types.PresignURLInput, body io.Reader) (*types.UploadObjectOutput, error)
+	ObjectKeyFromURL(fileURL string) string
+	PublicURL(objectKey string) string
+
+	// Nesne yönetimi
+	HeadObject(ctx context.Context, objectKey string) (*types.ObjectInfo, error)
+	GetObject(ctx context.Context, objectKey string) (io.ReadCloser, error)
+	DeleteObject(ctx context.Context, objectKey string) error
+	ListObjects(ctx context.Context, prefix string) ([]types.ObjectInfo, error)
+	CopyObject(ctx context.Context, sourceKey, destinationKey string) error
+
+	// Çok parçalı yükleme
+	CreateMultipartUpload(ctx context.Context, input types.PresignURLInput) (*types.MultipartUploadOutput, error)
+	PresignUploadParts(ctx context.Context, objectKey, uploadID string, partNumbers []int32) ([]types.MultipartPartURL, time.Time, error)
+	CompleteMultipartUpload(ctx context.Context, objectKey, uploadID string, parts []types.CompletedPart) error
+	AbortMultipartUpload(ctx context.Context, objectKey, uploadID string) error
+	AbortStaleMultipartUploads(ctx context.Context, initiatedBefore time.Time) (int, error)
+
+	TestConnection(ctx context.Context) error
+}
+
+// Derleme zamanında R2 servisinin arayüzü karşıladığını doğrula
+var _ Storage = (*R2Service.Service)(nil)
+
+// NewStorageService, ortam değişkenlerine göre uygun storage servisini döndürür.
+// STORAGE_DRIVER: "r2" (varsayılan), "s3" (MinIO vb.) veya "local".
+func NewStorageService() Storage {
+	driver := os.Getenv("STORAGE_DRIVER")
+
+	switch driver {
+	case "local":
+		rootDir := os.Getenv("LOCAL_STORAGE_PATH")
+		if rootDir == "" {
+			rootDir = "./storage"
+		}
+
+		publicURLBase := os.Getenv("LOCAL_STORAGE_PUBLIC_URL")
+		if publicURLBase == "" {
+			port := os.Getenv("PORT")
+			if port == "" {
+				port = "8080"
+			}
+			publicURLBase = fmt.Sprintf("http://localhost:%s/storage", port)
+		}
+
+		fmt.Println("💾 [LOCAL STORAGE] : Starting local filesystem storage backend")
+		fmt.Printf("📁 Storage path : %s\n", rootDir)
+		return NewLocalStorage(rootDir, os.Getenv("LOCAL_STORAGE_FOLDER_NAME"), publicURLBase, os.Getenv("LOCAL_STORAGE_SECRET"))
+
+	case "s3":
+		fmt.Println("🪣 [S3 STORAGE] : Starting S3-compatible storage backend")
+		fmt.Printf("🔗 S3 endpoint : %s\n", os.Getenv("S3_ENDPOINT"))
+		return R2Service.NewS3Service(
+			os.Getenv("S3_ENDPOINT"),
+			os.Getenv("S3_REGION"),
+			os.Getenv("S3_ACCESS_KEY_ID"),
+			os.Getenv("S3_ACCESS_KEY_SECRET"),
+			os.Getenv("S3_BUCKET_NAME"),
+			os.Getenv("S3_FOLDER_NAME"),
+			os.Getenv("S3_PUBLIC_URL_BASE"),
+		)
+
+	default:
+		fmt.Println("☁️ [R2 STORAGE] : Starting Cloudflare R2 storage backend")
+		return R2Service.NewService(
+			os.Getenv("R2_ACCOUNT_ID"),
+			os.Getenv("R2_ACCESS_KEY_ID"),
+			os.Getenv("R2_ACCESS_KEY_SECRET"),
+			os.Getenv("R2_BUCKET_NAME"),
+			os.Getenv("R2_FOLDER_NAME"),
+			os.Getenv("R2_PUBLIC_URL_BASE"),
+			os.Getenv("R2_ENDPOINT"),
+		)
+	}
+}
@@ -0,0 +1,593 @@
+// services/storage/local.go
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+	"github.com/okanay/backend-template/utils"
+)
+
+// multipartDirName, yarım kalan çok parçalı yüklemelerin parçalarının tutulduğu klasör
+const multipartDirName = ".multipart"
+
+// localStorageMinSecretLength, imzalı URL'ler için kabul edilen en kısa secret uzunluğudur
+const localStorageMinSecretLength = 32
+
+// ===== LOCAL FILESYSTEM STORAGE IMPLEMENTATION =====
+
+// LocalStorage, dosyaları yerel diskte saklayan ve presigned URL'leri
+// Go sunucusunun kendisi üzerinden sunan storage implementasyonu.
+// Geliştirme ortamında ve testlerde R2 kimlik bilgisi gerektirmeden çalışır.
+type LocalStorage struct {
+	rootDir       string
+	folderName    string
+	publicURLBase string
+	secret        []byte
+}
+
+// Derleme zamanında yerel storage'ın arayüzü karşıladığını doğrula
+var _ Storage = (*LocalStorage)(nil)
+
+// NewLocalStorage yeni bir yerel storage instance'ı oluşturur
+func NewLocalStorage(rootDir, folderName, publicURLBase, secret string) *LocalStorage {
+	// Boş veya .env-example'dan kopyalanmış bir değerle imzalar tahmin edilebilir olurdu; sürücü başlatılmaz
+	if err := validateLocalStorageSecret(secret); err != nil {
+		panic(fmt.Sprintf("Local storage configuration error: %s", err))
+	}
+
+	if err := os.MkdirAll(filepath.Join(rootDir, multipartDirName), 0755); err != nil {
+		panic(fmt.Sprintf("Local storage configuration error: %s", err))
+	}
+
+	return &LocalStorage{
+		rootDir:       rootDir,
+		folderName:    folderName,
+		publicURLBase: strings.TrimRight(publicURLBase, "/"),
+		secret:        []byte(secret),
+	}
+}
+
+func (l *LocalStorage) GeneratePresignedURL(ctx context.Context, input types.PresignURLInput) (*types.PresignedURLOutput, error) {
+	objectPath := utils.BuildObjectKey(l.folderName, input.Filename, input.FileCategory, input.Visibility)
+
+	params := url.Values{}
+	params.Set("size", strconv.FormatInt(input.SizeInBytes, 10))
+	if input.ContentHash != "" {
+		params.Set("sha256", strings.ToLower(input.ContentHash))
+	}
+
+	return &types.PresignedURLOutput{
+		PresignedURL: l.signURL(http.MethodPut, objectPath, configs.FILE_PRESIGNED_URL_DURATION, params),
+		UploadURL:    l.PublicURL(objectPath),
+		ObjectKey:    objectPath,
+		ExpiresAt:    time.Now().Add(configs.FILE_PRESIGNED_URL_DURATION),
+	}, nil
+}
+
+func (l *LocalStorage) GeneratePresignedGetURL(ctx context.Context, objectKey string, expires time.Duration, downloadFilename string) (string, error) {
+	params := url.Values{}
+	if downloadFilename != "" {
+		params.Set("response-content-disposition", utils.ContentDisposition(downloadFilename))
+	}
+	return l.signURL(http.MethodGet, objectKey, expires, params), nil
+}
+
+func (l *LocalStorage) UploadObject(ctx context.Context, input types.PresignURLInput, body io.Reader) (*types.UploadObjectOutput, error) {
+	objectPath := utils.BuildObjectKey(l.folderName, input.Filename, input.FileCategory, input.Visibility)
+
+	counter := &countingReader{reader: body}
+	if err := l.writeObject(objectPath, counter); err != nil {
+		return nil, fmt.Errorf("nesne yüklenemedi: %w", err)
+	}
+
+	return &types.UploadObjectOutput{
+		ObjectKey:   objectPath,
+		UploadURL:   l.PublicURL(objectPath),
+		SizeInBytes: counter.count,
+	}, nil
+}
+
+func (l *LocalStorage) ObjectKeyFromURL(fileURL string) string {
+	return strings.TrimPrefix(fileURL, l.publicURLBase+"/")
+}
+
+func (l *LocalStorage) GetObject(ctx context.Context, objectKey string) (io.ReadCloser, error) {
+	filePath, err := l.objectPath(objectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("nesne okunamadı (key: %s): %w", objectKey, err)
+	}
+	return file, nil
+}
+
+func (l *LocalStorage) HeadObject(ctx context.Context, objectKey string) (*types.ObjectInfo, error) {
+	filePath, err := l.objectPath(objectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil // Nesne bulunamadı
+		}
+		return nil, fmt.Errorf("nesne bilgisi alınamadı (key: %s): %w", objectKey, err)
+	}
+
+	etag, err := fileDigest(filePath, md5.New)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := fileDigest(filePath, sha256.New)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ObjectInfo{
+		Key:            objectKey,
+		SizeInBytes:    stat.Size(),
+		ContentType:    mime.TypeByExtension(path.Ext(objectKey)),
+		ETag:           `"` + etag + `"`,
+		ChecksumSHA256: checksum,
+		LastModified:   stat.ModTime(),
+	}, nil
+}
+
+func (l *LocalStorage) DeleteObject(ctx context.Context, objectKey string) error {
+	filePath, err := l.objectPath(objectKey)
+	if err != nil {
+		return err
+	}
+
+	// S3 davranışıyla uyumlu olarak, olmayan bir nesneyi silmek hata değildir
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("nesne silinemedi (key: %s): %w", objectKey, err)
+	}
+	return nil
+}
+
+func (l *LocalStorage) ListObjects(ctx context.Context, prefix string) ([]types.ObjectInfo, error) {
+	var objects []types.ObjectInfo
+
+	err := filepath.WalkDir(l.rootDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == multipartDirName {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		relPath, err := filepath.Rel(l.rootDir, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(relPath)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, types.ObjectInfo{
+			Key:          key,
+			SizeInBytes:  info.Size(),
+			ContentType:  mime.TypeByExtension(path.Ext(key)),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("nesneler listelenemedi (prefix: %s): %w", prefix, err)
+	}
+
+	return objects, nil
+}
+
+func (l *LocalStorage) CopyObject(ctx context.Context, sourceKey, destinationKey string) error {
+	sourcePath, err := l.objectPath(sourceKey)
+	if err != nil {
+		return err
+	}
+
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return fmt.Errorf("nesne kopyalanamadı (%s -> %s): %w", sourceKey, destinationKey, err)
+	}
+	defer source.Close()
+
+	if err := l.writeObject(destinationKey, source); err != nil {
+		return fmt.Errorf("nesne kopyalanamadı (%s -> %s): %w", sourceKey, destinationKey, err)
+	}
+	return nil
+}
+
+func (l *LocalStorage) CreateMultipartUpload(ctx context.Context, input types.PresignURLInput) (*types.MultipartUploadOutput, error) {
+	objectPath := utils.BuildObjectKey(l.folderName, input.Filename, input.FileCategory, input.Visibility)
+	uploadID := utils.GenerateRandomString(32)
+
+	uploadDir := filepath.Join(l.rootDir, multipartDirName, uploadID)
+	if err := os.MkdirAll(uploadDir, 0755); err != nil {
+		return nil, fmt.Errorf("multipart upload başlatılamadı: %w", err)
+	}
+
+	return &types.MultipartUploadOutput{
+		UploadID:  uploadID,
+		ObjectKey: objectPath,
+		UploadURL: l.PublicURL(objectPath),
+	}, nil
+}
+
+func (l *LocalStorage) PresignUploadParts(ctx context.Context, objectKey, uploadID string, partNumbers []int32) ([]types.MultipartPartURL, time.Time, error) {
+	expiresAt := time.Now().Add(configs.FILE_MULTIPART_URL_DURATION)
+
+	parts := make([]types.MultipartPartURL, 0, len(partNumbers))
+	for _, partNumber := range partNumbers {
+		params := url.Values{}
+		params.Set("uploadId", uploadID)
+		params.Set("partNumber", strconv.Itoa(int(partNumber)))
+
+		parts = append(parts, types.MultipartPartURL{
+			PartNumber: partNumber,
+			URL:        l.signURL(http.MethodPut, objectKey, configs.FILE_MULTIPART_URL_DURATION, params),
+		})
+	}
+
+	return parts, expiresAt, nil
+}
+
+func (l *LocalStorage) CompleteMultipartUpload(ctx context.Context, objectKey, uploadID string, parts []types.CompletedPart) error {
+	uploadDir, err := l.multipartDir(uploadID)
+	if err != nil {
+		return err
+	}
+
+	// Parçaları sırayla okuyup ETag'lerini doğrulayan tek bir akış oluştur
+	readers := make([]io.Reader, 0, len(parts))
+	for _, part := range parts {
+		partPath := filepath.Join(uploadDir, strconv.Itoa(int(part.PartNumber)))
+
+		etag, err := fileDigest(partPath, md5.New)
+		if err != nil {
+			return fmt.Errorf("multipart upload tamamlanamadı, parça okunamadı (part: %d): %w", part.PartNumber, err)
+		}
+		if etag != strings.Trim(part.ETag, `"`) {
+			return fmt.Errorf("multipart upload tamamlanamadı, ETag uyuşmuyor (part: %d)", part.PartNumber)
+		}
+
+		partFile, err := os.Open(partPath)
+		if err != nil {
+			return err
+		}
+		defer partFile.Close()
+		readers = append(readers, partFile)
+	}
+
+	if err := l.writeObject(objectKey, io.MultiReader(readers...)); err != nil {
+		return fmt.Errorf("multipart upload tamamlanamadı (key: %s): %w", objectKey, err)
+	}
+
+	return os.RemoveAll(uploadDir)
+}
+
+func (l *LocalStorage) AbortMultipartUpload(ctx context.Context, objectKey, uploadID string) error {
+	uploadDir, err := l.multipartDir(uploadID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(uploadDir); err != nil {
+		return fmt.Errorf("multipart upload iptal edilemedi (key: %s): %w", objectKey, err)
+	}
+	return nil
+}
+
+func (l *LocalStorage) AbortStaleMultipartUploads(ctx context.Context, initiatedBefore time.Time) (int, error) {
+	entries, err := os.ReadDir(filepath.Join(l.rootDir, multipartDirName))
+	if err != nil {
+		return 0, fmt.Errorf("multipart upload listesi alınamadı: %w", err)
+	}
+
+	aborted := 0
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !entry.IsDir() || !info.ModTime().Before(initiatedBefore) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(l.rootDir, multipartDirName, entry.Name())); err != nil {
+			return aborted, err
+		}
+		aborted++
+	}
+
+	return aborted, nil
+}
+
+func (l *LocalStorage) TestConnection(ctx context.Context) error {
+	testFile := filepath.Join(l.rootDir, ".write-test")
+	if err := os.WriteFile(testFile, []byte("ok"), 0644); err != nil {
+		return fmt.Errorf("local storage bağlantı testi başarısız: %w", err)
+	}
+	os.Remove(testFile)
+
+	fmt.Printf("Local storage bağlantı testi başarılı! (%s)\n", l.rootDir)
+	return nil
+}
+
+// ===== HTTP HANDLERS =====
+
+// HandleUpload, presigned PUT URL'lerine gelen yüklemeleri karşılar.
+// Tek parçalı yüklemeler ve multipart parçaları aynı endpoint üzerinden gelir.
+func (l *LocalStorage) HandleUpload(c *gin.Context) {
+	objectKey := strings.TrimPrefix(c.Param("key"), "/")
+	if !l.verifySignature(http.MethodPut, objectKey, c.Request.URL.Query()) {
+		c.String(http.StatusForbidden, "SignatureDoesNotMatch")
+		return
+	}
+
+	// İmzalı boyut bilgisi varsa, gövde tam olarak o boyutta olmalı
+	body := c.Request.Body
+	if sizeParam := c.Query("size"); sizeParam != "" {
+		size, _ := strconv.ParseInt(sizeParam, 10, 64)
+		if c.Request.ContentLength != size {
+			c.String(http.StatusBadRequest, "InvalidContentLength")
+			return
+		}
+		body = http.MaxBytesReader(c.Writer, c.Request.Body, size)
+	}
+
+	// İmzalı içerik özeti varsa, gövde bu özetle eşleşmezse nesne yazılmaz (S3 x-amz-checksum-sha256 davranışı)
+	if expected := c.Query("sha256"); expected != "" {
+		body = &checksumReader{reader: body, hash: sha256.New(), expected: expected}
+	}
+
+	// Multipart parçası ise parçayı geçici klasöre yaz
+	if uploadID := c.Query("uploadId"); uploadID != "" {
+		uploadDir, err := l.multipartDir(uploadID)
+		if err != nil {
+			c.String(http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+
+		partNumber, err := strconv.Atoi(c.Query("partNumber"))
+		if err != nil || partNumber < 1 {
+			c.String(http.StatusBadRequest, "InvalidPartNumber")
+			return
+		}
+
+		partPath := filepath.Join(uploadDir, strconv.Itoa(partNumber))
+		etag, err := writeFileWithMD5(partPath, body)
+		if err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		c.Header("ETag", `"`+etag+`"`)
+		c.Status(http.StatusOK)
+		return
+	}
+
+	if err := l.writeObject(objectKey, body); err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// HandleDownload, yerel olarak saklanan dosyaları sunar.
+// İmza parametresi varsa doğrulanır; yoksa dosya public kabul edilir.
+// Private önek altındaki dosyalar sadece imzalı URL ile sunulur.
+func (l *LocalStorage) HandleDownload(c *gin.Context) {
+	objectKey := strings.TrimPrefix(c.Param("key"), "/")
+	query := c.Request.URL.Query()
+
+	isPrivate := strings.HasPrefix(objectKey, configs.FILE_PRIVATE_PREFIX+"/")
+	if (query.Has("signature") || isPrivate) && !l.verifySignature(http.MethodGet, objectKey, query) {
+		c.String(http.StatusForbidden, "SignatureDoesNotMatch")
+		return
+	}
+
+	if disposition := query.Get("response-content-disposition"); disposition != "" {
+		c.Header("Content-Disposition", disposition)
+	}
+
+	filePath, err := l.objectPath(objectKey)
+	if err != nil {
+		c.String(http.StatusBadRequest, "InvalidKey")
+		return
+	}
+
+	c.File(filePath)
+}
+
+// ===== HELPERS =====
+
+// validateLocalStorageSecret, imzalı URL'ler için kullanılacak secret'ın boş, kısa veya bir komut/yer tutucu olmadığını denetler
+func validateLocalStorageSecret(secret string) error {
+	switch {
+	case strings.TrimSpace(secret) == "":
+		return errors.New("LOCAL_STORAGE_SECRET is required")
+	case strings.ContainsAny(secret, " \t") || strings.Contains(secret, "openssl"):
+		return errors.New("LOCAL_STORAGE_SECRET looks like a placeholder, set it to the output of `openssl rand -base64 32`")
+	case len(secret) < localStorageMinSecretLength:
+		return fmt.Errorf("LOCAL_STORAGE_SECRET must be at least %d characters", localStorageMinSecretLength)
+	}
+	return nil
+}
+
+// signURL, verilen metot ve anahtar için HMAC ile imzalanmış, süresi kısıtlı bir URL üretir
+func (l *LocalStorage) signURL(method, objectKey string, expires time.Duration, params url.Values) string {
+	params.Set("expires", strconv.FormatInt(time.Now().Add(expires).Unix(), 10))
+	params.Set("signature", l.signature(method, objectKey, params))
+	return l.PublicURL(objectKey) + "?" + params.Encode()
+}
+
+// verifySignature, gelen isteğin imzasını ve süresini doğrular
+func (l *LocalStorage) verifySignature(method, objectKey string, query url.Values) bool {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return false
+	}
+
+	expected := l.signature(method, objectKey, query)
+	return hmac.Equal([]byte(expected), []byte(query.Get("signature")))
+}
+
+// signature, imza parametresi hariç tüm sorgu parametrelerini kapsayan HMAC-SHA256 değerini hesaplar
+func (l *LocalStorage) signature(method, objectKey string, params url.Values) string {
+	signed := url.Values{}
+	for key, values := range params {
+		if key != "signature" {
+			signed[key] = values
+		}
+	}
+
+	mac := hmac.New(sha256.New, l.secret)
+	mac.Write([]byte(method + "\n" + objectKey + "\n" + signed.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (l *LocalStorage) PublicURL(objectKey string) string {
+	return fmt.Sprintf("%s/%s", l.publicURLBase, objectKey)
+}
+
+// objectPath, object key'i disk üzerindeki yola çevirir ve kök dizin dışına çıkılmasını engeller
+func (l *LocalStorage) objectPath(objectKey string) (string, error) {
+	cleanKey := path.Clean("/" + objectKey)[1:]
+	if cleanKey == "" || cleanKey != objectKey || strings.HasPrefix(cleanKey, multipartDirName) {
+		return "", fmt.Errorf("geçersiz object key: %s", objectKey)
+	}
+	return filepath.Join(l.rootDir, filepath.FromSlash(cleanKey)), nil
+}
+
+// multipartDir, bir upload ID'ye ait parça klasörünün yolunu döndürür
+func (l *LocalStorage) multipartDir(uploadID string) (string, error) {
+	if uploadID == "" || strings.ContainsAny(uploadID, `/\.`) {
+		return "", fmt.Errorf("geçersiz upload ID: %s", uploadID)
+	}
+
+	uploadDir := filepath.Join(l.rootDir, multipartDirName, uploadID)
+	if _, err := os.Stat(uploadDir); err != nil {
+		return "", fmt.Errorf("multipart upload bulunamadı: %s", uploadID)
+	}
+	return uploadDir, nil
+}
+
+// writeObject, içeriği önce geçici bir dosyaya yazar ve ardından atomik olarak yerine taşır
+func (l *LocalStorage) writeObject(objectKey string, content io.Reader) error {
+	filePath, err := l.objectPath(objectKey)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+
+	tmpPath := filePath + ".tmp-" + utils.GenerateRandomString(8)
+	if _, err := writeFileWithMD5(tmpPath, content); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, filePath)
+}
+
+// writeFileWithMD5, içeriği dosyaya yazar ve MD5 özetini (S3 ETag formatında) döndürür
+func writeFileWithMD5(filePath string, content io.Reader) (string, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), content); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// fileDigest, bir dosyanın verilen algoritmayla hesaplanan özetini hex olarak döndürür
+func fileDigest(filePath string, newHash func() hash.Hash) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	digest := newHash()
+	if _, err := io.Copy(digest, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+// checksumReader, okunan içeriğin özetini hesaplar ve akışın sonunda beklenen özetle eşleşmezse hata döner.
+// Böylece writeObject geçici dosyayı yerine taşımadan yazmayı iptal eder.
+type checksumReader struct {
+	reader   io.Reader
+	hash     hash.Hash
+	expected string
+}
+
+func (r *checksumReader) Close() error {
+	if closer, ok := r.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF && hex.EncodeToString(r.hash.Sum(nil)) != r.expected {
+		return n, errors.New("BadDigest: içerik özeti uyuşmuyor")
+	}
+	return n, err
+}
+
+// countingReader okunan byte sayısını tutar
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
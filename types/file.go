@@ -14,17 +14,81 @@ const (
 	FileStatusError   FileStatus = "Error"
 )
 
+// FileRecordStatus, files tablosundaki kaydın durumudur
+type FileRecordStatus string
+
+const (
+	FileRecordStatusActive      FileRecordStatus = "active"
+	FileRecordStatusQuarantined FileRecordStatus = "quarantined" // Zararlı yazılım taraması sürüyor, listelenmez
+	FileRecordStatusScanFailed  FileRecordStatus = "scan_failed" // Taranamadı (boyut sınırı veya tekrarlanan hata), listelenmez
+	FileRecordStatusDeleted     FileRecordStatus = "deleted"
+)
+
+// FileScanResult, bir zararlı yazılım taramasının sonucudur
+type FileScanResult string
+
+const (
+	FileScanResultClean    FileScanResult = "clean"
+	FileScanResultInfected FileScanResult = "infected"
+	FileScanResultError    FileScanResult = "error"
+)
+
+// ScanResult, Scanner implementasyonlarının döndürdüğü tarama sonucu
+type ScanResult struct {
+	Infected  bool
+	Signature string // Tespit edilen zararlı yazılımın adı (ör. Eicar-Test-Signature)
+}
+
+// FileScanEvent, files_scan_events tablosundaki bir tarama kaydını temsil eder
+type FileScanEvent struct {
+	ID           uuid.UUID      `json:"id"`
+	FileID       uuid.UUID      `json:"fileId"`
+	ObjectKey    string         `json:"objectKey"`
+	Filename     string         `json:"filename"`
+	FileCategory string         `json:"fileCategory"`
+	Scanner      string         `json:"scanner"`
+	Result       FileScanResult `json:"result"`
+	Signature    *string        `json:"signature,omitempty"`
+	Message      *string        `json:"message,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+}
+
+type FileVisibility string
+
+const (
+	FileVisibilityPublic  FileVisibility = "public"
+	FileVisibilityPrivate FileVisibility = "private"
+)
+
+// FileCategoryPolicy bir dosya kategorisinin yükleme ve erişim kurallarını tanımlar
+type FileCategoryPolicy struct {
+	Name               string         `json:"name"`
+	Description        string         `json:"description"`
+	AllowedMimeTypes   []string       `json:"allowedMimeTypes"`
+	AllowedExtensions  []string       `json:"allowedExtensions"`
+	MaxSize            int64          `json:"maxSize"`
+	Visibility         FileVisibility `json:"visibility"`
+	RetentionDays      int            `json:"retentionDays"`                // Süresi dolan dosyalar çöp kutusuna taşınır; 0 ise süresiz saklanır
+	RequiredPermission Permission     `json:"requiredPermission,omitempty"` // Boşsa ek yetki gerekmez
+	ImageProcessing    bool           `json:"imageProcessing"`              // /v1/files/categories ile frontend'e bildirilir; görseller yüklemeden önce işlenir (boyut, blur vb.)
+}
+
 // File dosya tablosundaki kayıtlar için
 type File struct {
-	ID           uuid.UUID `json:"id"`
-	URL          string    `json:"url"`
-	FileType     string    `json:"fileType"`
-	Filename     string    `json:"filename"`
-	FileCategory string    `json:"fileCategory"`
-	SizeInBytes  int64     `json:"sizeInBytes"`
-	Status       string    `json:"status"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID           uuid.UUID      `json:"id"`
+	URL          string         `json:"url"`
+	ObjectKey    string         `json:"objectKey"`
+	FileType     string         `json:"fileType"`
+	Filename     string         `json:"filename"`
+	FileCategory string         `json:"fileCategory"`
+	SizeInBytes  int64          `json:"sizeInBytes"`
+	Status       string         `json:"status"`
+	Visibility   FileVisibility `json:"visibility"`
+	ContentHash  *string        `json:"contentHash,omitempty"`
+	Tags         []string       `json:"tags"`
+	DeletedAt    *time.Time     `json:"deletedAt,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
 }
 
 // FileCreateInput bir dosya oluşturmak için girdi (artık kullanılmıyor olabilir, ama güncelleyelim)
@@ -37,71 +101,291 @@ type FileCreateInput struct {
 }
 
 type UploadSignature struct {
-	ID           uuid.UUID `json:"id"`
-	PresignedURL string    `json:"presignedUrl"`
-	UploadURL    string    `json:"uploadUrl"`
-	Filename     string    `json:"filename"`
-	FileType     string    `json:"fileType"`
-	FileCategory string    `json:"fileCategory"`
-	ExpiresAt    time.Time `json:"expiresAt"`
-	Completed    bool      `json:"completed"`
-	CreatedAt    time.Time `json:"createdAt"`
+	ID           uuid.UUID      `json:"id"`
+	PresignedURL string         `json:"presignedUrl"`
+	UploadURL    string         `json:"uploadUrl"`
+	ObjectKey    *string        `json:"objectKey,omitempty"`
+	Filename     string         `json:"filename"`
+	FileType     string         `json:"fileType"`
+	FileCategory string         `json:"fileCategory"`
+	Visibility   FileVisibility `json:"visibility"`
+	ContentHash  *string        `json:"contentHash,omitempty"`
+	SizeInBytes  int64          `json:"sizeInBytes"`
+	UploadedBy   *uuid.UUID     `json:"uploadedBy,omitempty"`
+	ExpiresAt    time.Time      `json:"expiresAt"`
+	Completed    bool           `json:"completed"`
+	CreatedAt    time.Time      `json:"createdAt"`
 }
 
 // UploadSignature imza tablosundaki kayıtlar için
 type UploadSignatureInput struct {
 	PresignedURL string
 	UploadURL    string
+	ObjectKey    string
 	Filename     string
 	FileType     string
 	FileCategory string
+	Visibility   FileVisibility
+	ContentHash  *string
+	SizeInBytes  int64 // Kotadan ayrılan yer
+	UploadedBy   uuid.UUID
 	ExpiresAt    time.Time
 }
 
 type SaveFileInput struct {
 	URL          string
+	ObjectKey    string
 	Filename     string
 	FileType     string
 	FileCategory string
 	SizeInBytes  int64
+	Visibility   FileVisibility
+	ContentHash  *string
+	Status       FileRecordStatus // Boşsa kayıt doğrudan aktif olur
+	UploadedBy   *uuid.UUID
 }
 
 // PresignURLInput Presigned URL oluşturmak için girdi (artık kullanılmıyor olabilir, birleştirildi)
 type PresignURLInput struct {
-	Filename     string `json:"filename" validate:"required"`
-	ContentType  string `json:"contentType" validate:"required"`
-	FileCategory string `json:"fileCategory"`
-	SizeInBytes  int64  `json:"sizeInBytes" validate:"required,max=10485760"`
+	Filename     string         `json:"filename" validate:"required"`
+	ContentType  string         `json:"contentType" validate:"required"`
+	FileCategory string         `json:"fileCategory"`
+	SizeInBytes  int64          `json:"sizeInBytes" validate:"required,max=10485760"`
+	Visibility   FileVisibility `json:"-"` // Private nesneler public olmayan bir önek altında saklanır
+	ContentHash  string         `json:"-"` // Verilirse (hex SHA-256) storage yüklenen içeriği bu özete göre doğrular
 }
 
 type PresignedURLOutput struct {
-	PresignedURL string    `json:"presignedUrl"`
-	UploadURL    string    `json:"uploadUrl"`
-	ObjectKey    string    `json:"objectKey"`
-	ExpiresAt    time.Time `json:"expiresAt"`
+	PresignedURL string            `json:"presignedUrl"`
+	UploadURL    string            `json:"uploadUrl"`
+	ObjectKey    string            `json:"objectKey"`
+	Headers      map[string]string `json:"headers,omitempty"` // İstemcinin yükleme isteğinde göndermesi gereken imzalı başlıklar
+	ExpiresAt    time.Time         `json:"expiresAt"`
 }
 
 type CreatePresignedURLInput struct {
 	Filename     string `json:"filename" validate:"required"`
 	ContentType  string `json:"contentType" validate:"required"`
-	FileCategory string `json:"fileCategory" validate:"omitempty"`                 // Opsiyonel olabilir
-	SizeInBytes  int64  `json:"sizeInBytes" validate:"required,gt=0,max=10485760"` // 0'dan büyük olmalı
+	FileCategory string `json:"fileCategory" validate:"omitempty"`                   // Opsiyonel olabilir
+	SizeInBytes  int64  `json:"sizeInBytes" validate:"required,gt=0"`                // Üst sınır kategoriye göre kontrol edilir
+	ContentHash  string `json:"contentHash" validate:"omitempty,len=64,hexadecimal"` // Opsiyonel, dosyanın SHA-256 özeti (hex)
 }
 
 type CreatePresignedURLResponse struct {
-	ID           string    `json:"id"`
-	PresignedURL string    `json:"presignedUrl"`
-	UploadURL    string    `json:"uploadUrl"`
-	ExpiresAt    time.Time `json:"expiresAt"`
-	Filename     string    `json:"filename"`
+	ID           string            `json:"id,omitempty"`
+	PresignedURL string            `json:"presignedUrl,omitempty"`
+	UploadURL    string            `json:"uploadUrl,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	ExpiresAt    time.Time         `json:"expiresAt,omitzero"`
+	Filename     string            `json:"filename"`
+	Duplicate    bool              `json:"duplicate"`      // Aynı içerik daha önce yüklenmişse true, yeni URL üretilmez
+	File         *FileResponse     `json:"file,omitempty"` // Duplicate ise mevcut dosya
+}
+
+// FileResponse istemciye döndürülen dosya özeti
+type FileResponse struct {
+	ID         string         `json:"id"`
+	URL        string         `json:"url"`
+	Visibility FileVisibility `json:"visibility"`
 }
 
 type ConfirmUploadInput struct {
 	SignatureID  string `json:"signatureId" validate:"required,uuid"`
-	URL          string `json:"url" validate:"required,url"`
+	URL          string `json:"url" validate:"omitempty,url"` // Geriye dönük uyumluluk için kabul edilir; kayıt imzadaki nesne anahtarıyla yapılır
 	FileCategory string `json:"fileCategory" validate:"omitempty"`
-	SizeInBytes  int64  `json:"sizeInBytes" validate:"required,gt=0,max=10485760"`
+	SizeInBytes  int64  `json:"sizeInBytes" validate:"omitempty,gt=0"`      // Geriye dönük uyumluluk için kabul edilir; boyut storage'daki nesneden okunur
 	Width        int    `json:"width,omitempty" validate:"omitempty,gt=0"`  // Varsa 0'dan büyük olmalı
 	Height       int    `json:"height,omitempty" validate:"omitempty,gt=0"` // Varsa 0'dan büyük olmalı
 	AltText      string `json:"altText,omitempty"`
 }
+
+type MultipartUploadStatus string
+
+const (
+	MultipartUploadStatusPending   MultipartUploadStatus = "pending"
+	MultipartUploadStatusCompleted MultipartUploadStatus = "completed"
+	MultipartUploadStatusAborted   MultipartUploadStatus = "aborted"
+)
+
+// MultipartUpload çok parçalı yükleme tablosundaki kayıtlar için
+type MultipartUpload struct {
+	ID           uuid.UUID             `json:"id"`
+	UploadID     string                `json:"uploadId"`
+	ObjectKey    string                `json:"objectKey"`
+	UploadURL    string                `json:"uploadUrl"`
+	Filename     string                `json:"filename"`
+	FileType     string                `json:"fileType"`
+	FileCategory string                `json:"fileCategory"`
+	SizeInBytes  int64                 `json:"sizeInBytes"`
+	Visibility   FileVisibility        `json:"visibility"`
+	PartSize     int64                 `json:"partSize"`
+	PartCount    int32                 `json:"partCount"`
+	Status       MultipartUploadStatus `json:"status"`
+	UploadedBy   *uuid.UUID            `json:"uploadedBy,omitempty"`
+	ExpiresAt    time.Time             `json:"expiresAt"`
+	CreatedAt    time.Time             `json:"createdAt"`
+	UpdatedAt    time.Time             `json:"updatedAt"`
+}
+
+type MultipartUploadInput struct {
+	UploadID     string
+	ObjectKey    string
+	UploadURL    string
+	Filename     string
+	FileType     string
+	FileCategory string
+	SizeInBytes  int64
+	Visibility   FileVisibility
+	PartSize     int64
+	PartCount    int32
+	UploadedBy   uuid.UUID
+	ExpiresAt    time.Time
+}
+
+// UploadObjectOutput sunucu üzerinden storage'a aktarılan bir nesnenin bilgileri
+type UploadObjectOutput struct {
+	ObjectKey   string `json:"objectKey"`
+	UploadURL   string `json:"uploadUrl"`
+	SizeInBytes int64  `json:"sizeInBytes"`
+}
+
+type MultipartUploadOutput struct {
+	UploadID  string `json:"uploadId"`
+	ObjectKey string `json:"objectKey"`
+	UploadURL string `json:"uploadUrl"`
+}
+
+type MultipartPartURL struct {
+	PartNumber int32  `json:"partNumber"`
+	URL        string `json:"url"`
+}
+
+type CompletedPart struct {
+	PartNumber int32  `json:"partNumber" validate:"required,gt=0"`
+	ETag       string `json:"etag" validate:"required"`
+}
+
+type InitiateMultipartUploadInput struct {
+	Filename     string `json:"filename" validate:"required"`
+	ContentType  string `json:"contentType" validate:"required"`
+	FileCategory string `json:"fileCategory" validate:"omitempty"`
+	SizeInBytes  int64  `json:"sizeInBytes" validate:"required,gt=0"`
+}
+
+type InitiateMultipartUploadResponse struct {
+	ID        string             `json:"id"`
+	UploadID  string             `json:"uploadId"`
+	UploadURL string             `json:"uploadUrl"`
+	PartSize  int64              `json:"partSize"`
+	PartCount int32              `json:"partCount"`
+	Parts     []MultipartPartURL `json:"parts"`
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Filename  string             `json:"filename"`
+}
+
+type RefreshMultipartPartsInput struct {
+	PartNumbers []int32 `json:"partNumbers" validate:"omitempty,dive,gt=0"` // Boşsa tüm parçalar yenilenir
+}
+
+type CompleteMultipartUploadInput struct {
+	Parts []CompletedPart `json:"parts" validate:"required,min=1,dive"`
+}
+
+// ObjectInfo storage üzerindeki bir nesnenin metadata bilgileri
+type ObjectInfo struct {
+	Key            string    `json:"key"`
+	SizeInBytes    int64     `json:"sizeInBytes"`
+	ContentType    string    `json:"contentType,omitempty"`
+	ETag           string    `json:"etag,omitempty"`
+	ChecksumSHA256 string    `json:"checksumSha256,omitempty"` // Hex SHA-256, storage tarafından hesaplanmışsa
+	LastModified   time.Time `json:"lastModified"`
+}
+
+type FileReferenceSource string
+
+const (
+	FileReferenceSourceContent    FileReferenceSource = "content"
+	FileReferenceSourceUserAvatar FileReferenceSource = "user_avatar"
+)
+
+// FileReference bir dosya URL'sinin nerede kullanıldığını gösteren referans kaydı
+type FileReference struct {
+	ID              uuid.UUID           `json:"id"`
+	FileURL         string              `json:"fileUrl"`
+	ObjectKey       string              `json:"objectKey"`
+	SourceType      FileReferenceSource `json:"sourceType"`
+	ContentCategory *string             `json:"contentCategory,omitempty"`
+	ContentPath     *string             `json:"contentPath,omitempty"`
+	ContentBranch   *string             `json:"contentBranch,omitempty"`
+	UserID          *string             `json:"userId,omitempty"`
+	CreatedAt       time.Time           `json:"createdAt"`
+}
+
+type FileBatchDeleteInput struct {
+	IDs   []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+	Force bool     `json:"force"` // Kullanımdaki dosyaları da sil
+}
+
+type FileBatchMoveInput struct {
+	IDs      []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+	Category string   `json:"category" validate:"required"`
+	Force    bool     `json:"force"` // Kullanımdaki dosyaları da taşı (içeriklerdeki eski URL'ler kırılır)
+}
+
+type FileBatchTagInput struct {
+	IDs    []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+	Add    []string `json:"add" validate:"omitempty,max=50,dive,required,max=64"`
+	Remove []string `json:"remove" validate:"omitempty,max=50,dive,required,max=64"`
+}
+
+// FileBatchItemResult toplu işlemdeki tek bir dosyanın sonucu
+type FileBatchItemResult struct {
+	ID      string   `json:"id"`
+	Success bool     `json:"success"`
+	Error   string   `json:"error,omitempty"`
+	Message string   `json:"message,omitempty"`
+	URL     string   `json:"url,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// FileBatchResponse toplu işlemin kısmi başarı raporu
+type FileBatchResponse struct {
+	Total     int                   `json:"total"`
+	Succeeded int                   `json:"succeeded"`
+	Failed    int                   `json:"failed"`
+	Results   []FileBatchItemResult `json:"results"`
+}
+
+// FileQuota, bir rol için depolama sınırlarıdır. 0 sınırsız anlamına gelir.
+type FileQuota struct {
+	TotalBytes    int64            `json:"totalBytes"`
+	CategoryBytes map[string]int64 `json:"categoryBytes,omitempty"`
+}
+
+// FileCategoryUsage, bir kategorideki kullanılan ve rezerve edilen alan
+type FileCategoryUsage struct {
+	Category      string `json:"category"`
+	UsedBytes     int64  `json:"usedBytes"`
+	ReservedBytes int64  `json:"reservedBytes"` // Henüz tamamlanmamış ve süresi dolmamış yüklemeler
+	FileCount     int    `json:"fileCount"`
+}
+
+// FileStorageUsage, bir kullanıcının depolama kullanımıdır
+type FileStorageUsage struct {
+	UserID        string              `json:"userId"`
+	Email         string              `json:"email,omitempty"`
+	UsedBytes     int64               `json:"usedBytes"`
+	ReservedBytes int64               `json:"reservedBytes"`
+	FileCount     int                 `json:"fileCount"`
+	Categories    []FileCategoryUsage `json:"categories"`
+}
+
+// CategoryBytes, verilen kategoride kullanılan ve rezerve edilen alanın toplamını döndürür
+func (u *FileStorageUsage) CategoryBytes(category string) int64 {
+	for _, usage := range u.Categories {
+		if usage.Category == category {
+			return usage.UsedBytes + usage.ReservedBytes
+		}
+	}
+	return 0
+}
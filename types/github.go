@@ -0,0 +1,222 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublishRequestStatus, bir içerik yayınlama talebinin durumudur
+type PublishRequestStatus string
+
+const (
+	PublishRequestStatusPending   PublishRequestStatus = "pending"
+	PublishRequestStatusApproved  PublishRequestStatus = "approved" // Onaylandı, birleştirme sürüyor
+	PublishRequestStatusPublished PublishRequestStatus = "published"
+	PublishRequestStatusFailed    PublishRequestStatus = "failed"
+	PublishRequestStatusRejected  PublishRequestStatus = "rejected"
+)
+
+// PublishRequest, github_publish_requests tablosundaki bir yayınlama talebini temsil eder
+type PublishRequest struct {
+	ID            uuid.UUID               `json:"id"`
+	Category      string                  `json:"category"`
+	Branch        string                  `json:"branch"`
+	HeadSHA       string                  `json:"headSha"`
+	PRNumber      int                     `json:"prNumber"`
+	PRURL         string                  `json:"prUrl"`
+	Message       *string                 `json:"message,omitempty"`
+	Status        PublishRequestStatus    `json:"status"`
+	RequestedBy   uuid.UUID               `json:"requestedBy"`
+	ReviewedBy    *uuid.UUID              `json:"reviewedBy,omitempty"`
+	ReviewNote    *string                 `json:"reviewNote,omitempty"`
+	ReviewedAt    *time.Time              `json:"reviewedAt,omitempty"`
+	ResultMessage *string                 `json:"resultMessage,omitempty"`
+	Comments      []PublishRequestComment `json:"comments,omitempty"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	UpdatedAt     time.Time               `json:"updatedAt"`
+}
+
+// CreatePublishRequestInput, yeni bir yayınlama talebi oluşturmak için gereken bilgilerdir
+type CreatePublishRequestInput struct {
+	Category    string
+	Branch      string
+	HeadSHA     string
+	PRNumber    int
+	PRURL       string
+	Message     *string
+	RequestedBy uuid.UUID
+}
+
+// PublishRequestComment, bir yayınlama talebine yazılmış yorumdur
+type PublishRequestComment struct {
+	ID        uuid.UUID `json:"id"`
+	RequestID uuid.UUID `json:"requestId"`
+	UserID    uuid.UUID `json:"userId"`
+	UserEmail string    `json:"userEmail"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// PublishScheduleStatus, zamanlanmış bir yayınlamanın durumudur
+type PublishScheduleStatus string
+
+const (
+	PublishScheduleStatusScheduled PublishScheduleStatus = "scheduled"
+	PublishScheduleStatusRunning   PublishScheduleStatus = "running" // Yayınlama sürüyor
+	PublishScheduleStatusPublished PublishScheduleStatus = "published"
+	PublishScheduleStatusSkipped   PublishScheduleStatus = "skipped" // Taslak zamanlamadan sonra değiştiği için yayınlanmadı
+	PublishScheduleStatusFailed    PublishScheduleStatus = "failed"
+	PublishScheduleStatusCancelled PublishScheduleStatus = "cancelled"
+)
+
+// PublishSchedule, github_publish_schedules tablosundaki zamanlanmış bir yayınlamayı temsil eder
+type PublishSchedule struct {
+	ID                uuid.UUID             `json:"id"`
+	Category          string                `json:"category"`
+	Branch            string                `json:"branch"`
+	HeadSHA           string                `json:"headSha"`
+	PublishAt         time.Time             `json:"publishAt"`
+	AllowDraftChanges bool                  `json:"allowDraftChanges"`
+	Message           *string               `json:"message,omitempty"`
+	Status            PublishScheduleStatus `json:"status"`
+	ResultMessage     *string               `json:"resultMessage,omitempty"`
+	CreatedBy         uuid.UUID             `json:"createdBy"`
+	CancelledBy       *uuid.UUID            `json:"cancelledBy,omitempty"`
+	ExecutedAt        *time.Time            `json:"executedAt,omitempty"`
+	CreatedAt         time.Time             `json:"createdAt"`
+	UpdatedAt         time.Time             `json:"updatedAt"`
+}
+
+// CreatePublishScheduleInput, yeni bir zamanlanmış yayınlama oluşturmak için gereken bilgilerdir
+type CreatePublishScheduleInput struct {
+	Category          string
+	Branch            string
+	HeadSHA           string
+	PublishAt         time.Time
+	AllowDraftChanges bool
+	Message           *string
+	CreatedBy         uuid.UUID
+}
+
+// PublishSource, bir yayının hangi akışla main'e alındığını belirtir
+type PublishSource string
+
+const (
+	PublishSourceDirect   PublishSource = "direct"
+	PublishSourceApproval PublishSource = "approval"
+	PublishSourceSchedule PublishSource = "schedule"
+	PublishSourceRollback PublishSource = "rollback"
+)
+
+// PublishedFile, bir yayında değişen tek bir dosyadır
+type PublishedFile struct {
+	Path         string `json:"path"`
+	PreviousPath string `json:"previousPath,omitempty"`
+	Status       string `json:"status"` // "added", "modified", "deleted", "renamed"
+}
+
+// PublishRecord, github_publish_history tablosundaki bir yayın kaydını temsil eder
+type PublishRecord struct {
+	ID           uuid.UUID       `json:"id"`
+	Category     string          `json:"category"`
+	Source       PublishSource   `json:"source"`
+	PRNumber     *int            `json:"prNumber,omitempty"`
+	MergeSHA     string          `json:"mergeSha"`
+	BaseSHA      string          `json:"baseSha"`
+	Files        []PublishedFile `json:"files"`
+	Message      *string         `json:"message,omitempty"`
+	Report       *string         `json:"report,omitempty"`
+	PublishedBy  *uuid.UUID      `json:"publishedBy,omitempty"`
+	RollbackOf   *uuid.UUID      `json:"rollbackOf,omitempty"`
+	RolledBackAt *time.Time      `json:"rolledBackAt,omitempty"`
+	CreatedAt    time.Time       `json:"createdAt"`
+}
+
+// CreatePublishRecordInput, yeni bir yayın kaydı oluşturmak için gereken bilgilerdir
+type CreatePublishRecordInput struct {
+	Category    string
+	Source      PublishSource
+	PRNumber    *int
+	MergeSHA    string
+	BaseSHA     string
+	Files       []PublishedFile
+	Message     *string
+	Report      *string
+	PublishedBy *uuid.UUID
+	RollbackOf  *uuid.UUID
+}
+
+// CSSPolicy, tema dosyalarında izin verilen at-rule'ları ve url() adreslerini tanımlar
+type CSSPolicy struct {
+	AllowedAtRules    []string // "@" olmadan, küçük harfle (ör. "media")
+	AllowedURLSchemes []string // Göreli adresler her zaman serbesttir (ör. "https", "data")
+	AllowedURLHosts   []string // Uzak adreslerde izin verilen sunucular; "*.example.com" alt alan adlarını kapsar
+}
+
+// ContentValidator, bir içerik kategorisindeki dosyaların hangi kurallara göre doğrulanacağını belirtir
+type ContentValidator string
+
+const (
+	ContentValidatorNone ContentValidator = "none"
+	ContentValidatorJSON ContentValidator = "json" // JSON sözdizimi ve varsa şema
+	ContentValidatorI18n ContentValidator = "i18n" // JSON doğrulamasına ek olarak dil dosyalarının anahtar uyumu
+	ContentValidatorCSS  ContentValidator = "css"  // CSS/SCSS sözdizimi, at-rule ve url() kuralları
+)
+
+// ContentCategory, github_content_categories tablosundaki bir içerik kategorisini temsil eder
+type ContentCategory struct {
+	Type        string           `json:"type"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	RepoOwner   *string          `json:"repoOwner,omitempty"` // Boşsa GITHUB_OWNER kullanılır
+	RepoName    *string          `json:"repoName,omitempty"`  // Boşsa GITHUB_REPOSITORY_NAME kullanılır
+	BaseBranch  string           `json:"baseBranch"`
+	Path        string           `json:"path"`
+	Extensions  []string         `json:"extensions"`
+	MaxSize     int64            `json:"maxSize"` // byte, 0 sınırsız
+	Validator   ContentValidator `json:"validator"`
+	DraftBranch string           `json:"draftBranch"`
+	SchemaPath  *string          `json:"schemaPath,omitempty"`
+	UserDrafts  bool             `json:"userDrafts"` // Her kullanıcı kendi taslak branch'inde çalışır (ör. "i18n-draft/<userId>")
+	Enabled     bool             `json:"enabled"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	UpdatedAt   time.Time        `json:"updatedAt"`
+}
+
+// ContentCategoryInput, bir içerik kategorisini oluşturmak veya güncellemek için gereken bilgilerdir
+type ContentCategoryInput struct {
+	Type        string
+	Name        string
+	Description string
+	RepoOwner   *string
+	RepoName    *string
+	BaseBranch  string
+	Path        string
+	Extensions  []string
+	MaxSize     int64
+	Validator   ContentValidator
+	DraftBranch string
+	SchemaPath  *string
+	UserDrafts  bool
+	Enabled     bool
+}
+
+// ContentCategoryRequest, admin panelinden kategori oluşturma ve güncelleme isteğinin gövdesidir.
+// Güncellemede type yerine rotadaki kategori adı kullanılır. Boş bırakılan branch'ler varsayılan değerleri alır.
+type ContentCategoryRequest struct {
+	Type        string           `json:"type" validate:"omitempty,max=50"`
+	Name        string           `json:"name" validate:"required,max=100"`
+	Description string           `json:"description" validate:"max=500"`
+	RepoOwner   *string          `json:"repoOwner" validate:"omitempty,max=100"`
+	RepoName    *string          `json:"repoName" validate:"omitempty,max=100"`
+	BaseBranch  string           `json:"baseBranch" validate:"max=255"`
+	Path        string           `json:"path" validate:"required,max=255"`
+	Extensions  []string         `json:"extensions" validate:"required,min=1,dive,required,max=20"`
+	MaxSize     int64            `json:"maxSize" validate:"gte=0"`
+	Validator   ContentValidator `json:"validator" validate:"omitempty,oneof=none json i18n css"`
+	DraftBranch string           `json:"draftBranch" validate:"max=255"`
+	SchemaPath  *string          `json:"schemaPath" validate:"omitempty,max=255"`
+	UserDrafts  bool             `json:"userDrafts"`
+	Enabled     *bool            `json:"enabled"` // Boşsa kategori etkin oluşturulur
+}
@@ -14,10 +14,17 @@ const (
 	CanGetIP Permission = "test:ip"
 
 	// File Permissions
-	CanGetPresignedURL Permission = "file:presigned-url"
-	CanConfirmUpload   Permission = "file:confirm-upload"
-	CanListFiles       Permission = "file:list"
-	CanDeleteFile      Permission = "file:delete"
+	CanGetPresignedURL    Permission = "file:presigned-url"
+	CanConfirmUpload      Permission = "file:confirm-upload"
+	CanUploadFile         Permission = "file:upload"
+	CanListFiles          Permission = "file:list"
+	CanDeleteFile         Permission = "file:delete"
+	CanRestoreFile        Permission = "file:restore"
+	CanViewTrash          Permission = "file:view-trash"
+	CanMoveFile           Permission = "file:move"
+	CanTagFile            Permission = "file:tag"
+	CanDownloadFile       Permission = "file:download"
+	CanManagePrivateFiles Permission = "file:manage-private"
 
 	// Content (Github) Permissions
 	CanViewGithubCategories  Permission = "github:view-categories"
@@ -26,6 +33,7 @@ const (
 	CanViewGithubDraftStatus Permission = "github:view-draft-status"
 	CanPublishGithubContent  Permission = "github:publish"
 	CanRestartGithubCategory Permission = "github:restart-category"
+	CanApproveGithubPublish  Permission = "github:approve-publish"
 )
 
 // --- Veritabanı Modelleri ---
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// HexToBase64, hex formatındaki bir özeti S3 checksum başlıklarının beklediği base64 formatına çevirir
+func HexToBase64(hexDigest string) (string, error) {
+	raw, err := hex.DecodeString(hexDigest)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// Base64ToHex, S3'ten dönen base64 checksum değerini hex formatına çevirir.
+// Çok parçalı yüklemelerin bileşik checksum'ları ("...-N") çevrilemez ve boş string döner.
+func Base64ToHex(base64Digest string) string {
+	raw, err := base64.StdEncoding.DecodeString(base64Digest)
+	if err != nil || strings.Contains(base64Digest, "-") {
+		return ""
+	}
+	return hex.EncodeToString(raw)
+}
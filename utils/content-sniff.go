@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// sniffCompatibleTypes, içerik koklamanın bazı bildirilen tipler için döndürdüğü genel sonuçlardır.
+// Örneğin DOCX dosyaları zip arşivi, SVG dosyaları ise XML/metin olarak tespit edilir.
+var sniffCompatibleTypes = map[string][]string{
+	"image/svg+xml":      {"text/xml", "text/plain"},
+	"application/msword": {"application/octet-stream"},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": {"application/zip"},
+}
+
+// sniffDetectableTypes, http.DetectContentType'ın imzasından güvenilir şekilde tanıdığı tiplerdir.
+// Bu tiplerle bildirilen bir dosya tanınmazsa, içerik bildirilen tiple uyuşmuyor kabul edilir.
+var sniffDetectableTypes = []string{
+	"image/jpeg", "image/png", "image/gif", "image/webp", "image/bmp",
+	"application/pdf", "video/mp4", "video/webm",
+}
+
+// SniffContentType, içeriğin ilk byte'larına bakarak medya tipini parametresiz olarak döndürür
+func SniffContentType(head []byte) string {
+	return MediaType(http.DetectContentType(head))
+}
+
+// MediaType, Content-Type değerinden parametreleri atarak küçük harfli medya tipini döndürür
+func MediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	}
+	return mediaType
+}
+
+// IsSniffedTypeCompatible, koklanan içerik tipinin bildirilen tiple uyumlu olup olmadığını döndürür.
+// Koklayıcının tanımadığı formatlar (application/octet-stream) sadece bildirilen tip de tanınabilir değilse kabul edilir.
+func IsSniffedTypeCompatible(declared, sniffed string) bool {
+	declared = MediaType(declared)
+	sniffed = MediaType(sniffed)
+
+	if declared == sniffed || slices.Contains(sniffCompatibleTypes[declared], sniffed) {
+		return true
+	}
+
+	// Metin dosyaları her zaman text/plain olarak tespit edilir
+	if sniffed == "text/plain" && strings.HasPrefix(declared, "text/") {
+		return true
+	}
+
+	return sniffed == "application/octet-stream" && !slices.Contains(sniffDetectableTypes, declared)
+}
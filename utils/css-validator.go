@@ -0,0 +1,580 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/okanay/backend-template/types"
+)
+
+// CSSIssue, bir stil dosyasındaki tek bir sözdizimi veya güvenlik hatasıdır
+type CSSIssue struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+type cssTokenKind int
+
+const (
+	cssIdent cssTokenKind = iota
+	cssFunction
+	cssAtKeyword
+	cssHash
+	cssString
+	cssURL
+	cssNumber
+	cssDelim
+	cssOpen
+	cssClose
+	cssSemicolon
+	cssColon
+	cssComma
+)
+
+type cssToken struct {
+	kind   cssTokenKind
+	value  string // Ad ve string değerleri kaçış karakterleri çözülmüş haliyle tutulur
+	line   int
+	column int
+}
+
+// ValidateCSS, CSS veya SCSS içeriğini belirteçlerine ayırarak doğrular.
+// Kapanmamış string/yorum/ayraç gibi sözdizimi hatalarını, izin verilmeyen at-rule'ları, expression() ve
+// eski tarayıcıların betik çalıştıran özelliklerini, izin verilmeyen url() şemalarını ve sunucularını satır
+// numarasıyla raporlar. scss true ise "//" yorumları, #{} enterpolasyonu ve SCSS at-rule'ları tanınır.
+func ValidateCSS(content string, scss bool, policy types.CSSPolicy) []CSSIssue {
+	tokenizer := &cssTokenizer{input: []rune(content), line: 1, column: 1, scss: scss}
+	var tokens []cssToken
+	for {
+		token, ok := tokenizer.next()
+		if !ok {
+			break
+		}
+		tokens = append(tokens, token)
+	}
+
+	validator := &cssValidator{policy: policy, scss: scss, issues: tokenizer.issues}
+	validator.validate(tokens)
+
+	slices.SortStableFunc(validator.issues, func(a, b CSSIssue) int {
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+	return validator.issues
+}
+
+type cssTokenizer struct {
+	input  []rune
+	pos    int
+	line   int
+	column int
+	scss   bool
+	issues []CSSIssue
+}
+
+func (t *cssTokenizer) peek(offset int) rune {
+	if t.pos+offset >= len(t.input) {
+		return 0
+	}
+	return t.input[t.pos+offset]
+}
+
+func (t *cssTokenizer) advance() rune {
+	r := t.input[t.pos]
+	t.pos++
+	if r == '\n' {
+		t.line++
+		t.column = 1
+	} else {
+		t.column++
+	}
+	return r
+}
+
+func (t *cssTokenizer) fail(line, column int, format string, args ...any) {
+	t.issues = append(t.issues, CSSIssue{Line: line, Column: column, Message: fmt.Sprintf(format, args...)})
+}
+
+// next bir sonraki anlamlı belirteci döndürür; boşluk ve yorumlar atlanır
+func (t *cssTokenizer) next() (cssToken, bool) {
+	for t.pos < len(t.input) {
+		line, column := t.line, t.column
+		token := func(kind cssTokenKind, value string) (cssToken, bool) {
+			return cssToken{kind: kind, value: value, line: line, column: column}, true
+		}
+
+		r := t.peek(0)
+		switch {
+		case unicode.IsSpace(r):
+			t.advance()
+
+		case r == '/' && t.peek(1) == '*':
+			t.advance()
+			t.advance()
+			for t.pos < len(t.input) && !(t.peek(0) == '*' && t.peek(1) == '/') {
+				t.advance()
+			}
+			if t.pos >= len(t.input) {
+				t.fail(line, column, "unterminated comment")
+				return cssToken{}, false
+			}
+			t.advance()
+			t.advance()
+
+		case r == '/' && t.peek(1) == '/' && t.scss:
+			for t.pos < len(t.input) && t.peek(0) != '\n' {
+				t.advance()
+			}
+
+		case r == '"' || r == '\'':
+			return token(cssString, t.consumeString())
+
+		case r == '@' && t.startsIdent(1):
+			t.advance()
+			return token(cssAtKeyword, t.consumeName())
+
+		case r == '#' && isCSSNameRune(t.peek(1)):
+			t.advance()
+			return token(cssHash, t.consumeName())
+
+		case unicode.IsDigit(r) || ((r == '.' || r == '+' || r == '-') && unicode.IsDigit(t.peek(1))) ||
+			((r == '+' || r == '-') && t.peek(1) == '.' && unicode.IsDigit(t.peek(2))):
+			return token(cssNumber, t.consumeNumber())
+
+		case t.startsIdent(0):
+			name := t.consumeName()
+			if t.peek(0) != '(' {
+				return token(cssIdent, name)
+			}
+			t.advance()
+			if strings.EqualFold(name, "url") {
+				if value, ok := t.consumeURL(line, column); ok {
+					return token(cssURL, value)
+				}
+			}
+			return token(cssFunction, name)
+
+		case r == '{' || r == '(' || r == '[':
+			t.advance()
+			return token(cssOpen, string(r))
+
+		case r == '}' || r == ')' || r == ']':
+			t.advance()
+			return token(cssClose, string(r))
+
+		case r == ';':
+			t.advance()
+			return token(cssSemicolon, ";")
+
+		case r == ':':
+			t.advance()
+			return token(cssColon, ":")
+
+		case r == ',':
+			t.advance()
+			return token(cssComma, ",")
+
+		case r == '\\':
+			// Ad başlatmayan kaçış (ör. satır sonundan önce)
+			t.fail(line, column, "invalid escape")
+			t.advance()
+
+		default:
+			t.advance()
+			return token(cssDelim, string(r))
+		}
+	}
+	return cssToken{}, false
+}
+
+// startsIdent, verilen konumdan itibaren bir CSS adının başladığını döndürür
+func (t *cssTokenizer) startsIdent(offset int) bool {
+	first := t.peek(offset)
+	switch {
+	case first == '-':
+		second := t.peek(offset + 1)
+		return isCSSNameStartRune(second) || second == '-' || (second == '\\' && t.peek(offset+2) != '\n')
+	case first == '\\':
+		return t.peek(offset+1) != '\n' && t.peek(offset+1) != 0
+	default:
+		return isCSSNameStartRune(first)
+	}
+}
+
+// consumeName bir adı kaçış karakterlerini çözerek okur; "exp\72 ession" gibi gizleme denemeleri de çözülür
+func (t *cssTokenizer) consumeName() string {
+	var name strings.Builder
+	for t.pos < len(t.input) {
+		r := t.peek(0)
+		switch {
+		case isCSSNameRune(r):
+			name.WriteRune(t.advance())
+		case r == '\\' && t.peek(1) != '\n' && t.peek(1) != 0:
+			t.advance()
+			name.WriteRune(t.consumeEscape())
+		default:
+			return name.String()
+		}
+	}
+	return name.String()
+}
+
+// consumeEscape ters eğik çizgiden sonraki kaçışı çözer: en fazla 6 onaltılık basamak ve isteğe bağlı bir boşluk
+func (t *cssTokenizer) consumeEscape() rune {
+	if !isHexRune(t.peek(0)) {
+		return t.advance()
+	}
+
+	var hex strings.Builder
+	for hex.Len() < 6 && isHexRune(t.peek(0)) {
+		hex.WriteRune(t.advance())
+	}
+	if unicode.IsSpace(t.peek(0)) {
+		t.advance()
+	}
+
+	code, _ := strconv.ParseInt(hex.String(), 16, 32)
+	if code == 0 || code > unicode.MaxRune || (code >= 0xD800 && code <= 0xDFFF) {
+		return unicode.ReplacementChar
+	}
+	return rune(code)
+}
+
+func (t *cssTokenizer) consumeNumber() string {
+	start := t.pos
+	if r := t.peek(0); r == '+' || r == '-' {
+		t.advance()
+	}
+	for unicode.IsDigit(t.peek(0)) || (t.peek(0) == '.' && unicode.IsDigit(t.peek(1))) {
+		t.advance()
+	}
+	if (t.peek(0) == 'e' || t.peek(0) == 'E') && (unicode.IsDigit(t.peek(1)) ||
+		((t.peek(1) == '+' || t.peek(1) == '-') && unicode.IsDigit(t.peek(2)))) {
+		t.advance()
+		for t.peek(0) == '+' || t.peek(0) == '-' || unicode.IsDigit(t.peek(0)) {
+			t.advance()
+		}
+	}
+
+	number := string(t.input[start:t.pos])
+	switch {
+	case t.peek(0) == '%':
+		t.advance()
+		number += "%"
+	case t.startsIdent(0):
+		number += t.consumeName()
+	}
+	return number
+}
+
+// consumeString tırnak içindeki metni okur. Kaçışsız satır sonu veya dosya sonu kapanmamış string hatasıdır.
+func (t *cssTokenizer) consumeString() string {
+	line, column := t.line, t.column
+	quote := t.advance()
+
+	var value strings.Builder
+	for t.pos < len(t.input) {
+		r := t.peek(0)
+		switch {
+		case r == quote:
+			t.advance()
+			return value.String()
+		case r == '\n':
+			t.fail(line, column, "unterminated string")
+			return value.String()
+		case r == '\\':
+			t.advance()
+			if t.pos >= len(t.input) {
+				continue
+			}
+			if t.peek(0) == '\n' {
+				t.advance() // Satır devamı
+				continue
+			}
+			value.WriteRune(t.consumeEscape())
+		default:
+			value.WriteRune(t.advance())
+		}
+	}
+
+	t.fail(line, column, "unterminated string")
+	return value.String()
+}
+
+// consumeURL, "url(" sonrasındaki tırnaksız adresi okur. Adres tırnaklıysa false döner ve
+// url bir fonksiyon olarak, string'i ayrı bir belirteç olarak okunur.
+func (t *cssTokenizer) consumeURL(line, column int) (string, bool) {
+	for unicode.IsSpace(t.peek(0)) {
+		t.advance()
+	}
+	if r := t.peek(0); r == '"' || r == '\'' {
+		return "", false
+	}
+
+	var value strings.Builder
+	for t.pos < len(t.input) {
+		r := t.peek(0)
+		switch {
+		case r == ')':
+			t.advance()
+			return value.String(), true
+		case unicode.IsSpace(r):
+			for unicode.IsSpace(t.peek(0)) {
+				t.advance()
+			}
+			if t.peek(0) == ')' {
+				t.advance()
+				return value.String(), true
+			}
+			t.fail(line, column, "invalid url(): unexpected whitespace")
+			t.skipBadURL()
+			return value.String(), true
+		case r == '"' || r == '\'' || r == '(' || unicode.IsControl(r):
+			t.fail(line, column, "invalid url(): unexpected %q", r)
+			t.skipBadURL()
+			return value.String(), true
+		case r == '\\':
+			t.advance()
+			if t.pos < len(t.input) {
+				value.WriteRune(t.consumeEscape())
+			}
+		default:
+			value.WriteRune(t.advance())
+		}
+	}
+
+	t.fail(line, column, "unterminated url()")
+	return value.String(), true
+}
+
+func (t *cssTokenizer) skipBadURL() {
+	for t.pos < len(t.input) {
+		if t.advance() == ')' {
+			return
+		}
+	}
+}
+
+func isCSSNameStartRune(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r >= 0x80
+}
+
+func isCSSNameRune(r rune) bool {
+	return isCSSNameStartRune(r) || r == '-' || (r >= '0' && r <= '9')
+}
+
+func isHexRune(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
+}
+
+// cssScriptProperties, eski tarayıcılarda betik çalıştırabilen özelliklerdir
+var cssScriptProperties = []string{"behavior", "-moz-binding"}
+
+type cssValidator struct {
+	policy types.CSSPolicy
+	scss   bool
+	issues []CSSIssue
+}
+
+func (v *cssValidator) fail(token cssToken, format string, args ...any) {
+	v.issues = append(v.issues, CSSIssue{Line: token.line, Column: token.column, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *cssValidator) validate(tokens []cssToken) {
+	var stack []cssToken
+	atRule := ""           // İçinde bulunulan at-rule'un prelüdü; ';' veya '{' ile biter
+	interpolationEnd := -1 // Son kapanan #{} enterpolasyonunun '}' belirteci; seçicinin parçasıdır
+
+	for i, token := range tokens {
+		var previous *cssToken
+		if i > 0 {
+			previous = &tokens[i-1]
+		}
+
+		switch token.kind {
+		case cssAtKeyword:
+			name := strings.ToLower(token.value)
+			if !slices.Contains(v.policy.AllowedAtRules, unprefixedAtRule(name)) {
+				v.fail(token, "at-rule @%s is not allowed", token.value)
+			}
+			atRule = name
+
+		case cssFunction:
+			// Fonksiyon adı açılış parantezini de içerir; kapanış parantezi ile eşleşmesi için yığına eklenir.
+			// image-set() argümanlarındaki string'ler adres olduğundan yığında fonksiyon olarak işaretlenir.
+			name := strings.ToLower(token.value)
+			opener := cssToken{kind: cssOpen, value: "(", line: token.line, column: token.column}
+			if name == "image-set" || strings.HasSuffix(name, "-image-set") {
+				opener.kind = cssFunction
+			}
+			stack = append(stack, opener)
+
+			switch {
+			case name == "expression" || strings.HasSuffix(name, "-expression"):
+				v.fail(token, "expression() is not allowed")
+			case name == "url" || name == "src":
+				if i+1 < len(tokens) && tokens[i+1].kind == cssString {
+					v.checkURL(tokens[i+1])
+				} else {
+					v.fail(token, "%s() value must be a literal string", name)
+				}
+			}
+
+		case cssURL:
+			v.checkURL(token)
+
+		case cssString:
+			// image-set("a.png" 1x) içindeki string'ler url() gibi denetlenir
+			if len(stack) > 0 && stack[len(stack)-1].kind == cssFunction {
+				v.checkURL(token)
+				continue
+			}
+			// @import/@use/@forward adresleri de url() gibi denetlenir
+			if atRule == "import" || atRule == "use" || atRule == "forward" {
+				if previous == nil || previous.kind != cssFunction {
+					v.checkURL(token)
+				}
+			}
+
+		case cssIdent:
+			if i+1 < len(tokens) && tokens[i+1].kind == cssColon && slices.Contains(cssScriptProperties, strings.ToLower(token.value)) {
+				v.fail(token, "property %s is not allowed", token.value)
+			}
+
+		case cssSemicolon:
+			atRule = ""
+
+		case cssOpen:
+			if token.value == "{" {
+				interpolation := v.scss && previous != nil && previous.kind == cssDelim && previous.value == "#"
+				if !interpolation && (previous == nil || previous.kind == cssSemicolon ||
+					(previous.kind == cssOpen && previous.value == "{") ||
+					(previous.kind == cssClose && previous.value == "}" && interpolationEnd != i-1)) {
+					v.fail(token, "block has no selector")
+				}
+				if interpolation {
+					token.kind = cssHash // Yığında enterpolasyon olarak işaretlenir
+				} else {
+					atRule = ""
+				}
+			}
+			stack = append(stack, token)
+
+		case cssClose:
+			opener := map[string]string{"}": "{", ")": "(", "]": "["}[token.value]
+			index := len(stack) - 1
+			for index >= 0 && stack[index].value != opener {
+				index--
+			}
+			if index < 0 {
+				v.fail(token, "unexpected '%s'", token.value)
+				continue
+			}
+			for _, unclosed := range stack[index+1:] {
+				v.fail(unclosed, "unclosed '%s'", unclosed.value)
+			}
+			if stack[index].kind == cssHash {
+				interpolationEnd = i
+			}
+			stack = stack[:index]
+		}
+	}
+
+	for _, unclosed := range stack {
+		v.fail(unclosed, "unclosed '%s'", unclosed.value)
+	}
+}
+
+// checkURL adresin şemasını ve uzak adreslerde sunucusunu politikaya göre denetler.
+// Göreli adresler (ör. "../fonts/a.woff2", "#id") her zaman kabul edilir.
+func (v *cssValidator) checkURL(token cssToken) {
+	raw := strings.TrimSpace(token.value)
+	if v.scss && (strings.HasPrefix(raw, "#{") || strings.HasPrefix(raw, "$")) {
+		v.fail(token, "dynamic url values cannot be verified, use a literal url")
+		return
+	}
+	if strings.HasPrefix(raw, "sass:") && v.scss {
+		return // Yerleşik SCSS modülleri (ör. @use "sass:math")
+	}
+
+	// Tarayıcılar şemadaki boşluk ve kontrol karakterlerini yok sayar ("java\tscript:")
+	normalized := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, raw)
+
+	if strings.HasPrefix(normalized, "//") {
+		normalized = "https:" + normalized
+	}
+
+	scheme, _, hasScheme := strings.Cut(normalized, ":")
+	if !hasScheme || !isURLScheme(scheme) {
+		return
+	}
+
+	scheme = strings.ToLower(scheme)
+	if !slices.Contains(v.policy.AllowedURLSchemes, scheme) {
+		v.fail(token, "url scheme %q is not allowed", scheme)
+		return
+	}
+	if scheme == "data" {
+		return
+	}
+
+	parsed, err := url.Parse(normalized)
+	if err != nil || parsed.Hostname() == "" {
+		v.fail(token, "invalid url %q", raw)
+		return
+	}
+	if !cssHostAllowed(strings.ToLower(parsed.Hostname()), v.policy.AllowedURLHosts) {
+		v.fail(token, "remote url host %q is not allowed", parsed.Hostname())
+	}
+}
+
+// isURLScheme, ':' öncesindeki metnin bir URL şeması olup olmadığını döndürür (ör. "c:" veya "a/b:" değildir)
+func isURLScheme(scheme string) bool {
+	if len(scheme) < 2 {
+		return false
+	}
+	for i, r := range scheme {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || (i > 0 && (r >= '0' && r <= '9' || r == '+' || r == '-' || r == '.'))) {
+			return false
+		}
+	}
+	return true
+}
+
+func cssHostAllowed(host string, allowed []string) bool {
+	for _, pattern := range allowed {
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+			continue
+		}
+		if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// unprefixedAtRule, tarayıcı önekli at-rule adlarını (ör. "-webkit-keyframes") öneksiz haline çevirir
+func unprefixedAtRule(name string) string {
+	if !strings.HasPrefix(name, "-") {
+		return name
+	}
+	if index := strings.Index(name[1:], "-"); index >= 0 {
+		return name[index+2:]
+	}
+	return name
+}
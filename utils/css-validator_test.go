@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/okanay/backend-template/types"
+)
+
+func TestValidateCSS(t *testing.T) {
+	policy := types.CSSPolicy{
+		AllowedAtRules:    []string{"media", "import", "use", "font-face", "keyframes", "mixin", "include"},
+		AllowedURLSchemes: []string{"https", "data"},
+		AllowedURLHosts:   []string{"fonts.example.com", "*.cdn.example.com"},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		scss    bool
+		want    []CSSIssue
+	}{
+		{
+			name:    "valid stylesheet",
+			content: "a { color: red; }\n@media (min-width: 10px) { .b { margin: 0 } }",
+		},
+		{
+			name:    "unterminated string",
+			content: "a { content: \"abc\n}",
+			want:    []CSSIssue{{Line: 1, Column: 14, Message: "unterminated string"}},
+		},
+		{
+			name:    "escaped newline in string",
+			content: "a { content: \"ab\\\nc\" }",
+		},
+		{
+			name:    "unterminated comment",
+			content: "a { }\n/* open",
+			want:    []CSSIssue{{Line: 2, Column: 1, Message: "unterminated comment"}},
+		},
+		{
+			name:    "comment content is ignored",
+			content: "/* url(javascript:x) behavior: y */ a { color: red }",
+		},
+		{
+			name:    "escaped expression",
+			content: "a { width: exp\\72 ession(alert(1)) }",
+			want:    []CSSIssue{{Line: 1, Column: 12, Message: "expression() is not allowed"}},
+		},
+		{
+			name:    "script property",
+			content: "a { behavior: url(x.htc) }",
+			want:    []CSSIssue{{Line: 1, Column: 5, Message: "property behavior is not allowed"}},
+		},
+		{
+			name:    "escaped script property",
+			content: "a { -moz-bind\\69ng: url(x.xml) }",
+			want:    []CSSIssue{{Line: 1, Column: 5, Message: "property -moz-binding is not allowed"}},
+		},
+		{
+			name:    "quoted url scheme",
+			content: `a { background: url("javascript:alert(1)") }`,
+			want:    []CSSIssue{{Line: 1, Column: 21, Message: `url scheme "javascript" is not allowed`}},
+		},
+		{
+			name:    "url scheme with escaped whitespace",
+			content: `a { background: url(java\9 script:x) }`,
+			want:    []CSSIssue{{Line: 1, Column: 17, Message: `url scheme "javascript" is not allowed`}},
+		},
+		{
+			name:    "url scheme not in policy",
+			content: `a { background: url(http://fonts.example.com/a.png) }`,
+			want:    []CSSIssue{{Line: 1, Column: 17, Message: `url scheme "http" is not allowed`}},
+		},
+		{
+			name:    "allowed host",
+			content: `@font-face { src: url(https://fonts.example.com/a.woff2) }`,
+		},
+		{
+			name:    "allowed subdomain",
+			content: `a { background: url(https://img.cdn.example.com/a.png) }`,
+		},
+		{
+			name:    "wildcard does not cover apex",
+			content: `a { background: url(https://cdn.example.com/a.png) }`,
+			want:    []CSSIssue{{Line: 1, Column: 17, Message: `remote url host "cdn.example.com" is not allowed`}},
+		},
+		{
+			name:    "protocol relative url",
+			content: `a { background: url(//evil.com/x.png) }`,
+			want:    []CSSIssue{{Line: 1, Column: 17, Message: `remote url host "evil.com" is not allowed`}},
+		},
+		{
+			name:    "data and relative urls",
+			content: `a { background: url(data:image/png;base64,AAAA), url(../img/a.png), url("#mask") }`,
+		},
+		{
+			name:    "image-set string urls",
+			content: `a { background: image-set("https://evil.example/x.png" 1x, "https://img.cdn.example.com/x.png" 2x, "a@3x.png" 3x) }`,
+			want:    []CSSIssue{{Line: 1, Column: 27, Message: `remote url host "evil.example" is not allowed`}},
+		},
+		{
+			name:    "prefixed image-set",
+			content: `a { background: -webkit-image-set("javascript:x" 1x, url(https://evil.example/y.png) 2x) }`,
+			want: []CSSIssue{
+				{Line: 1, Column: 35, Message: `url scheme "javascript" is not allowed`},
+				{Line: 1, Column: 54, Message: `remote url host "evil.example" is not allowed`},
+			},
+		},
+		{
+			name:    "unquoted url with whitespace",
+			content: `a { background: url(a b.png) }`,
+			want:    []CSSIssue{{Line: 1, Column: 17, Message: "invalid url(): unexpected whitespace"}},
+		},
+		{
+			name:    "import url",
+			content: `@import "https://evil.com/a.css";`,
+			want:    []CSSIssue{{Line: 1, Column: 9, Message: `remote url host "evil.com" is not allowed`}},
+		},
+		{
+			name:    "at-rule not allowed",
+			content: "@charset \"utf-8\";\na {}",
+			want:    []CSSIssue{{Line: 1, Column: 1, Message: "at-rule @charset is not allowed"}},
+		},
+		{
+			name:    "prefixed at-rule",
+			content: "@-webkit-keyframes spin { from { top: 0 } }",
+		},
+		{
+			name:    "unclosed block",
+			content: "a { color: red;",
+			want:    []CSSIssue{{Line: 1, Column: 3, Message: "unclosed '{'"}},
+		},
+		{
+			name:    "unexpected close",
+			content: "a { } }",
+			want:    []CSSIssue{{Line: 1, Column: 7, Message: "unexpected '}'"}},
+		},
+		{
+			name:    "block without selector",
+			content: "{ color: red }",
+			want:    []CSSIssue{{Line: 1, Column: 1, Message: "block has no selector"}},
+		},
+		{
+			name:    "issues are sorted by position",
+			content: "a {\n  behavior: x;\n  background: url(\"ftp://x\");\n",
+			want: []CSSIssue{
+				{Line: 1, Column: 3, Message: "unclosed '{'"},
+				{Line: 2, Column: 3, Message: "property behavior is not allowed"},
+				{Line: 3, Column: 19, Message: `url scheme "ftp" is not allowed`},
+			},
+		},
+		{
+			name:    "scss line comment",
+			content: "// url(javascript:x)\n$w: 10px;\n.a { width: $w; }",
+			scss:    true,
+		},
+		{
+			name:    "scss interpolated selector",
+			content: ".icon-#{$name} { color: red }",
+			scss:    true,
+		},
+		{
+			name:    "scss interpolated url",
+			content: `.a { background: url(#{$base}/a.png) }`,
+			scss:    true,
+			want:    []CSSIssue{{Line: 1, Column: 18, Message: "dynamic url values cannot be verified, use a literal url"}},
+		},
+		{
+			name:    "scss variable url",
+			content: `.a { background: url($image) }`,
+			scss:    true,
+			want:    []CSSIssue{{Line: 1, Column: 18, Message: "dynamic url values cannot be verified, use a literal url"}},
+		},
+		{
+			name:    "scss modules and mixins",
+			content: "@use \"sass:math\";\n@mixin m { color: red }\n.a { @include m; }",
+			scss:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateCSS(tt.content, tt.scss, policy)
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateCSS() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// urlPattern, metin içindeki http(s) URL'lerini yakalar. JSON, JS/TS ve CSS içerikleri için
+// tırnak, parantez ve boşluk karakterleri URL sınırı kabul edilir.
+var urlPattern = regexp.MustCompile(`https?://[^\s"'()<>\\` + "`" + `]+`)
+
+// ExtractURLs, içerikte geçen tüm benzersiz http(s) URL'lerini geçiş sırasıyla döndürür.
+// Sorgu ve fragment kısımları atılır; aynı dosyaya farklı parametrelerle verilen bağlantılar tek URL sayılır.
+func ExtractURLs(content string) []string {
+	matches := urlPattern.FindAllString(content, -1)
+
+	seen := make(map[string]bool, len(matches))
+	urls := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if i := strings.IndexAny(match, "?#"); i != -1 {
+			match = match[:i]
+		}
+		match = strings.TrimRight(match, ".,;")
+		if !seen[match] {
+			seen[match] = true
+			urls = append(urls, match)
+		}
+	}
+	return urls
+}
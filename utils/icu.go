@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ICUArguments, bir ICU MessageFormat mesajındaki argüman adlarını sıralı ve tekrarsız olarak döndürür.
+// "{name}", "{count, number}" ve "{count, plural, one {# item} other {# items}}" gibi argümanların yanı sıra
+// plural/select dallarının içindeki argümanlar da dahil edilir. Tek tırnakla kaçırılmış ayraçlar yok sayılır.
+func ICUArguments(message string) ([]string, error) {
+	parser := icuParser{input: []rune(message), arguments: map[string]bool{}}
+	if err := parser.parseMessage(false); err != nil {
+		return nil, err
+	}
+
+	arguments := make([]string, 0, len(parser.arguments))
+	for name := range parser.arguments {
+		arguments = append(arguments, name)
+	}
+	slices.Sort(arguments)
+	return arguments, nil
+}
+
+type icuParser struct {
+	input     []rune
+	pos       int
+	arguments map[string]bool
+}
+
+// parseMessage düz metni argümanlara kadar okur. nested true ise mesaj bir plural/select dalıdır ve '}' ile biter.
+func (p *icuParser) parseMessage(nested bool) error {
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case '\'':
+			p.skipQuoted()
+		case '{':
+			p.pos++
+			if err := p.parseArgument(); err != nil {
+				return err
+			}
+		case '}':
+			if nested {
+				return nil
+			}
+			return fmt.Errorf("unexpected '}' at position %d", p.pos+1)
+		default:
+			p.pos++
+		}
+	}
+
+	if nested {
+		return fmt.Errorf("unclosed '{' in message")
+	}
+	return nil
+}
+
+// parseArgument '{' sonrasındaki argümanı okur ve kapanış ayracını tüketir
+func (p *icuParser) parseArgument() error {
+	name := strings.TrimSpace(p.readUntil(",}"))
+	if name == "" {
+		return fmt.Errorf("empty argument name at position %d", p.pos+1)
+	}
+	if p.pos >= len(p.input) {
+		return fmt.Errorf("unclosed argument %q", name)
+	}
+	p.arguments[name] = true
+
+	if p.input[p.pos] == '}' {
+		p.pos++
+		return nil
+	}
+
+	p.pos++ // ','
+	argumentType := strings.TrimSpace(p.readUntil(",}"))
+	if p.pos >= len(p.input) {
+		return fmt.Errorf("unclosed argument %q", name)
+	}
+
+	switch argumentType {
+	case "plural", "select", "selectordinal":
+		if p.input[p.pos] != ',' {
+			return fmt.Errorf("argument %q of type %s has no options", name, argumentType)
+		}
+		p.pos++
+		return p.parseOptions(name)
+
+	default:
+		// number, date, time gibi biçimler; stil içeriği argüman içermez
+		depth := 1
+		for ; p.pos < len(p.input); p.pos++ {
+			switch p.input[p.pos] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					p.pos++
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("unclosed argument %q", name)
+	}
+}
+
+// parseOptions "one {...} other {...}" biçimindeki dalları okur ve argümanın kapanış ayracını tüketir
+func (p *icuParser) parseOptions(name string) error {
+	options := 0
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			return fmt.Errorf("unclosed argument %q", name)
+		}
+		if p.input[p.pos] == '}' {
+			p.pos++
+			if options == 0 {
+				return fmt.Errorf("argument %q has no options", name)
+			}
+			return nil
+		}
+
+		// Seçici "one", "=0" veya ilk dalda "offset:1 one" olabilir
+		selector := strings.TrimSpace(p.readUntil("{}"))
+		if selector == "" || p.pos >= len(p.input) || p.input[p.pos] != '{' {
+			return fmt.Errorf("invalid option in argument %q", name)
+		}
+
+		p.pos++
+		if err := p.parseMessage(true); err != nil {
+			return err
+		}
+		p.pos++ // Dalın kapanış ayracı
+		options++
+	}
+}
+
+func (p *icuParser) readUntil(stops string) string {
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(stops, p.input[p.pos]) {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+func (p *icuParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// skipQuoted ICU kaçış kurallarını uygular: art arda iki tek tırnak, tek bir tırnak karakteridir.
+// Özel bir karakterle ('{', '}', '#', '|') başlayan tırnaklı bölüm bir sonraki tek tırnağa kadar düz metindir.
+// Diğer tek tırnaklar olduğu gibi kalır.
+func (p *icuParser) skipQuoted() {
+	p.pos++
+	if p.pos >= len(p.input) {
+		return
+	}
+	switch p.input[p.pos] {
+	case '\'':
+		p.pos++
+	case '{', '}', '#', '|':
+		for p.pos < len(p.input) && p.input[p.pos] != '\'' {
+			p.pos++
+		}
+		p.pos++
+	}
+}
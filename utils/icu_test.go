@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestICUArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []string
+		wantErr string
+	}{
+		{name: "plain text", message: "Hello"},
+		{name: "simple argument", message: "Hello {name}", want: []string{"name"}},
+		{name: "repeated argument", message: "{a} and {a}", want: []string{"a"}},
+		{name: "sorted", message: "{b} {a}", want: []string{"a", "b"}},
+		{name: "formatted number", message: "{count, number} items", want: []string{"count"}},
+		{name: "date with style", message: "{date, date, short}", want: []string{"date"}},
+		{name: "plural", message: "{count, plural, one {# item} other {# items}}", want: []string{"count"}},
+		{name: "argument inside plural", message: "{count, plural, one {# item by {author}} other {# items by {author}}}", want: []string{"author", "count"}},
+		{name: "plural with offset and exact", message: "{n, plural, offset:1 =0 {none} one {one} other {#}}", want: []string{"n"}},
+		{name: "selectordinal", message: "{n, selectordinal, one {#st} two {#nd} other {#th}}", want: []string{"n"}},
+		{name: "select", message: "{gender, select, male {He} female {She} other {They}} invited {guest}", want: []string{"gender", "guest"}},
+		{name: "nested select in plural", message: "{n, plural, other {{g, select, other {x}}}}", want: []string{"g", "n"}},
+		{name: "quoted braces", message: "'{name}' is literal"},
+		{name: "quoted brace around argument", message: "'{' {real} '}'", want: []string{"real"}},
+		{name: "doubled apostrophe", message: "It''s {name}", want: []string{"name"}},
+		{name: "lone apostrophe", message: "don't {name}", want: []string{"name"}},
+		{name: "quoted hash in plural", message: "{n, plural, other {'#' {x}}}", want: []string{"n", "x"}},
+		{name: "unclosed argument", message: "Hello {name", wantErr: `unclosed argument "name"`},
+		{name: "unexpected close", message: "Hello }", wantErr: "unexpected '}' at position 7"},
+		{name: "empty name", message: "{}", wantErr: "empty argument name"},
+		{name: "plural without options", message: "{n, plural}", wantErr: "has no options"},
+		{name: "plural with empty options", message: "{n, plural, }", wantErr: `argument "n" has no options`},
+		{name: "option without message", message: "{n, select, other x}", wantErr: `invalid option in argument "n"`},
+		{name: "unclosed plural", message: "{n, plural, one {x} other {y}", wantErr: `unclosed argument "n"`},
+		{name: "unclosed option", message: "{n, plural, other {x", wantErr: "unclosed '{' in message"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ICUArguments(tt.message)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ICUArguments(%q) error = %v, want %q", tt.message, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ICUArguments(%q) error = %v", tt.message, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ICUArguments(%q) = %q, want %q", tt.message, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JSONKeyChange, iki JSON belgesi arasında değişen tek bir anahtarı temsil eder.
+// Pointer değerin JSON Pointer yoludur (ör. "/nav/0/title"); Key aynı yolun okunabilir halidir (ör. "nav[0].title").
+type JSONKeyChange struct {
+	Key     string `json:"key"`
+	Pointer string `json:"pointer"`
+	Old     any    `json:"old,omitempty"`
+	New     any    `json:"new,omitempty"`
+}
+
+// JSONDiff, iki JSON belgesi arasındaki anahtar bazlı farktır
+type JSONDiff struct {
+	Added   []JSONKeyChange `json:"added"`
+	Removed []JSONKeyChange `json:"removed"`
+	Changed []JSONKeyChange `json:"changed"`
+}
+
+// HasChanges, belgeler arasında anahtar seviyesinde bir fark olup olmadığını döndürür
+func (d *JSONDiff) HasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Changed) > 0
+}
+
+// DiffJSON, iki JSON belgesini anahtar seviyesinde karşılaştırır.
+// Değerler FlattenJSON ile JSON Pointer yollarına göre düzleştirilir; böylece girinti, anahtar sırası gibi biçim
+// farkları değişiklik olarak görünmez ve "a.b" anahtarı ile iç içe {"a":{"b":...}} birbirine karışmaz.
+// Boş içerik boş belge kabul edilir (yeni veya silinen dosya).
+func DiffJSON(oldContent, newContent []byte) (*JSONDiff, error) {
+	oldKeys, err := flattenJSONContent(oldContent)
+	if err != nil {
+		return nil, fmt.Errorf("old content: %w", err)
+	}
+	newKeys, err := flattenJSONContent(newContent)
+	if err != nil {
+		return nil, fmt.Errorf("new content: %w", err)
+	}
+
+	diff := &JSONDiff{
+		Added:   []JSONKeyChange{},
+		Removed: []JSONKeyChange{},
+		Changed: []JSONKeyChange{},
+	}
+
+	for _, pointer := range slices.Sorted(maps.Keys(newKeys)) {
+		oldValue, exists := oldKeys[pointer]
+		switch {
+		case !exists:
+			diff.Added = append(diff.Added, JSONKeyChange{Key: JSONPointerPath(pointer), Pointer: pointer, New: newKeys[pointer]})
+		case !reflect.DeepEqual(oldValue, newKeys[pointer]):
+			diff.Changed = append(diff.Changed, JSONKeyChange{Key: JSONPointerPath(pointer), Pointer: pointer, Old: oldValue, New: newKeys[pointer]})
+		}
+	}
+
+	for _, pointer := range slices.Sorted(maps.Keys(oldKeys)) {
+		if _, exists := newKeys[pointer]; !exists {
+			diff.Removed = append(diff.Removed, JSONKeyChange{Key: JSONPointerPath(pointer), Pointer: pointer, Old: oldKeys[pointer]})
+		}
+	}
+
+	return diff, nil
+}
+
+func flattenJSONContent(content []byte) (map[string]any, error) {
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return map[string]any{}, nil
+	}
+
+	var document any
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	return FlattenJSON(document), nil
+}
+
+// FlattenJSON, çözülmüş bir JSON değerini JSON Pointer yollarıyla anahtarlanan yaprak değerlere ayırır
+// (ör. {"nav":[{"title":"x"}]} -> "/nav/0/title": "x"). Boş nesne ve diziler kendi başına bir değer olarak saklanır;
+// kök seviyesindeki boş nesne anahtar üretmez. Yollar gösterim için JSONPointerPath ile çevrilebilir.
+func FlattenJSON(value any) map[string]any {
+	keys := make(map[string]any)
+	flattenJSONValue("", value, keys)
+	return keys
+}
+
+func flattenJSONValue(pointer string, value any, keys map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 && pointer != "" {
+			keys[pointer] = v
+			return
+		}
+		for key, child := range v {
+			flattenJSONValue(pointer+"/"+escapeJSONPointer(key), child, keys)
+		}
+
+	case []any:
+		if len(v) == 0 {
+			keys[pointer] = v
+			return
+		}
+		for i, child := range v {
+			flattenJSONValue(pointer+"/"+strconv.Itoa(i), child, keys)
+		}
+
+	default:
+		keys[pointer] = v
+	}
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want JSONDiff
+	}{
+		{
+			name: "formatting only",
+			old:  `{"a":1,"b":[1,2]}`,
+			new:  "{\n  \"b\": [1, 2],\n  \"a\": 1\n}",
+		},
+		{
+			name: "dotted key and nested key are distinct",
+			old:  `{"a.b":1,"a":{"b":2}}`,
+			new:  `{"a":{"b":2}}`,
+			want: JSONDiff{
+				Removed: []JSONKeyChange{{Key: `["a.b"]`, Pointer: "/a.b", Old: float64(1)}},
+			},
+		},
+		{
+			name: "nested change",
+			old:  `{"nav":[{"title":"Home"}]}`,
+			new:  `{"nav":[{"title":"Start"}]}`,
+			want: JSONDiff{
+				Changed: []JSONKeyChange{{Key: "nav[0].title", Pointer: "/nav/0/title", Old: "Home", New: "Start"}},
+			},
+		},
+		{
+			name: "escaped pointer",
+			old:  `{}`,
+			new:  `{"a/b":{"c~d":true}}`,
+			want: JSONDiff{
+				Added: []JSONKeyChange{{Key: "a/b.c~d", Pointer: "/a~1b/c~0d", New: true}},
+			},
+		},
+		{
+			name: "empty containers",
+			old:  `{"a":{"b":1}}`,
+			new:  `{"a":{},"c":[]}`,
+			want: JSONDiff{
+				Added:   []JSONKeyChange{{Key: "a", Pointer: "/a", New: map[string]any{}}, {Key: "c", Pointer: "/c", New: []any{}}},
+				Removed: []JSONKeyChange{{Key: "a.b", Pointer: "/a/b", Old: float64(1)}},
+			},
+		},
+		{
+			name: "new file",
+			old:  ``,
+			new:  `{"title":"x"}`,
+			want: JSONDiff{
+				Added: []JSONKeyChange{{Key: "title", Pointer: "/title", New: "x"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffJSON([]byte(tt.old), []byte(tt.new))
+			if err != nil {
+				t.Fatalf("DiffJSON() error = %v", err)
+			}
+
+			want := tt.want
+			for _, changes := range []*[]JSONKeyChange{&want.Added, &want.Removed, &want.Changed} {
+				if *changes == nil {
+					*changes = []JSONKeyChange{}
+				}
+			}
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("DiffJSON() = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,548 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// JSONSchema, JSON Schema'nın içerik doğrulaması için gereken alt kümesidir.
+// Desteklenen anahtar kelimeler: type, enum, const, properties, required, additionalProperties,
+// items, minItems, maxItems, minLength, maxLength, pattern, minimum, maximum, allOf, anyOf, oneOf
+// ve belge içi $ref (#/$defs/... veya #/definitions/...). Diğer anahtar kelimeler yok sayılır.
+type JSONSchema struct {
+	Type                 jsonSchemaTypes        `json:"type"`
+	Enum                 []any                  `json:"enum"`
+	Const                json.RawMessage        `json:"const"`
+	Properties           map[string]*JSONSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties"`
+	Items                *JSONSchema            `json:"items"`
+	MinItems             *int                   `json:"minItems"`
+	MaxItems             *int                   `json:"maxItems"`
+	MinLength            *int                   `json:"minLength"`
+	MaxLength            *int                   `json:"maxLength"`
+	Pattern              string                 `json:"pattern"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+	AllOf                []*JSONSchema          `json:"allOf"`
+	AnyOf                []*JSONSchema          `json:"anyOf"`
+	OneOf                []*JSONSchema          `json:"oneOf"`
+	Ref                  string                 `json:"$ref"`
+	Defs                 map[string]*JSONSchema `json:"$defs"`
+	Definitions          map[string]*JSONSchema `json:"definitions"`
+
+	boolean    *bool // true/false şemaları
+	pattern    *regexp.Regexp
+	constValue any
+	root       *JSONSchema
+}
+
+// JSONPosition, bir JSON belgesindeki 1 tabanlı satır ve sütundur
+type JSONPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// JSONSchemaError, belgenin şemaya uymayan tek bir değeridir. Pointer, değerin JSON Pointer yoludur (ör. "/nav/0/title").
+type JSONSchemaError struct {
+	Pointer string `json:"pointer"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e JSONSchemaError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%d:%d %s: %s", e.Line, e.Column, e.pointerLabel(), e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.pointerLabel(), e.Message)
+}
+
+func (e JSONSchemaError) pointerLabel() string {
+	if e.Pointer == "" {
+		return "/"
+	}
+	return e.Pointer
+}
+
+type jsonSchemaTypes []string
+
+func (t *jsonSchemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = jsonSchemaTypes{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("type must be a string or an array of strings")
+	}
+	*t = multiple
+	return nil
+}
+
+func (s *JSONSchema) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if string(trimmed) == "true" || string(trimmed) == "false" {
+		value := string(trimmed) == "true"
+		*s = JSONSchema{boolean: &value}
+		return nil
+	}
+
+	// Özyinelemeyi önlemek için metotları olmayan bir tür üzerinden çözülür
+	type plain JSONSchema
+	var schema plain
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return err
+	}
+	*s = JSONSchema(schema)
+	return nil
+}
+
+// ParseJSONSchema bir şema belgesini çözer ve desenleri derler
+func ParseJSONSchema(data []byte) (*JSONSchema, error) {
+	var schema JSONSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	if err := schema.compile(&schema); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	if err := schema.checkRefCycles(); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return &schema, nil
+}
+
+// jsonSchemaMaxDepth, doğrulamanın iç içe inebileceği en fazla şema seviyesidir. Döngü kontrolü sonsuz
+// özyinelemeyi zaten engeller; bu sınır aşırı derin belgelerde yığının taşmasını önleyen ikinci bir güvencedir.
+const jsonSchemaMaxDepth = 512
+
+// checkRefCycles, aynı değeri alt değere inmeden tekrar doğrulayan $ref döngülerini reddeder (ör. {"$ref":"#"}).
+// $ref, allOf, anyOf ve oneOf aynı değeri doğruladığından bu kenarlardan oluşan bir döngü hiç bitmez;
+// properties ve items üzerinden geçen özyinelemeli şemalar ise belge bittiğinde durduğundan serbesttir.
+func (s *JSONSchema) checkRefCycles() error {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[*JSONSchema]int{}
+
+	// ref, düğüme ulaşılan yoldaki son $ref'tir ve hata mesajında döngüyü göstermek için kullanılır
+	var visit func(node *JSONSchema, ref string) error
+	visit = func(node *JSONSchema, ref string) error {
+		if node == nil || state[node] == done {
+			return nil
+		}
+		if state[node] == visiting {
+			return fmt.Errorf("$ref cycle detected at %q", ref)
+		}
+		state[node] = visiting
+
+		if node.Ref != "" {
+			ref = node.Ref
+			if err := visit(node.resolveRef(), ref); err != nil {
+				return err
+			}
+		}
+		for _, child := range slices.Concat(node.AllOf, node.AnyOf, node.OneOf) {
+			if err := visit(child, ref); err != nil {
+				return err
+			}
+		}
+		state[node] = done
+		return nil
+	}
+
+	for _, node := range s.nodes() {
+		if err := visit(node, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodes, şemanın kendisini ve tüm alt şemalarını döndürür
+func (s *JSONSchema) nodes() []*JSONSchema {
+	if s == nil {
+		return nil
+	}
+	nodes := []*JSONSchema{s}
+	for _, child := range s.children() {
+		nodes = append(nodes, child.nodes()...)
+	}
+	return nodes
+}
+
+func (s *JSONSchema) children() []*JSONSchema {
+	children := slices.Concat(s.AllOf, s.AnyOf, s.OneOf, []*JSONSchema{s.AdditionalProperties, s.Items})
+	for _, group := range []map[string]*JSONSchema{s.Properties, s.Defs, s.Definitions} {
+		for _, child := range group {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func (s *JSONSchema) compile(root *JSONSchema) error {
+	if s == nil {
+		return nil
+	}
+	s.root = root
+
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("pattern %q: %w", s.Pattern, err)
+		}
+		s.pattern = pattern
+	}
+	if len(s.Const) > 0 {
+		if err := json.Unmarshal(s.Const, &s.constValue); err != nil {
+			return fmt.Errorf("const: %w", err)
+		}
+	}
+	if s.Ref != "" && s.Ref != "#" && !strings.HasPrefix(s.Ref, "#/$defs/") && !strings.HasPrefix(s.Ref, "#/definitions/") {
+		return fmt.Errorf("unsupported $ref %q, only local definitions are supported", s.Ref)
+	}
+
+	for _, child := range s.children() {
+		if err := child.compile(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate, belgeyi şemaya göre doğrular ve tüm hataları satır/sütun bilgisiyle döndürür.
+// Belge geçerli bir JSON değilse yalnızca sözdizimi hatası döner.
+func (s *JSONSchema) Validate(content []byte) []JSONSchemaError {
+	document, positions, syntaxErr := ParseJSONWithPositions(content)
+	if syntaxErr != nil {
+		return []JSONSchemaError{*syntaxErr}
+	}
+
+	var errs []JSONSchemaError
+	s.validate(document, "", 0, &errs)
+
+	for i := range errs {
+		if position, ok := positions[errs[i].Pointer]; ok {
+			errs[i].Line = position.Line
+			errs[i].Column = position.Column
+		}
+	}
+	return errs
+}
+
+func (s *JSONSchema) validate(value any, pointer string, depth int, errs *[]JSONSchemaError) {
+	if s == nil {
+		return
+	}
+	fail := func(format string, args ...any) {
+		*errs = append(*errs, JSONSchemaError{Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+	}
+	if depth > jsonSchemaMaxDepth {
+		fail("schema nesting exceeds %d levels", jsonSchemaMaxDepth)
+		return
+	}
+	depth++
+
+	if s.boolean != nil {
+		if !*s.boolean {
+			fail("value is not allowed")
+		}
+		return
+	}
+
+	if s.Ref != "" {
+		target := s.resolveRef()
+		if target == nil {
+			fail("schema reference %q not found", s.Ref)
+			return
+		}
+		target.validate(value, pointer, depth, errs)
+	}
+
+	if len(s.Type) > 0 && !slices.ContainsFunc(s.Type, func(t string) bool { return jsonTypeMatches(t, value) }) {
+		fail("expected %s, got %s", strings.Join(s.Type, " or "), jsonTypeName(value))
+		return
+	}
+
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(allowed any) bool { return reflect.DeepEqual(allowed, value) }) {
+		fail("value must be one of %s", formatJSONValues(s.Enum))
+	}
+	if len(s.Const) > 0 && !reflect.DeepEqual(s.constValue, value) {
+		fail("value must be %s", string(s.Const))
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		s.validateObject(v, pointer, depth, errs)
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			fail("array must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			fail("array must have at most %d items", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(item, pointer+"/"+strconv.Itoa(i), depth, errs)
+			}
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.MinLength != nil && length < *s.MinLength {
+			fail("string must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			fail("string must be at most %d characters", *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			fail("string does not match pattern %q", s.Pattern)
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			fail("number must be >= %v", *s.Minimum)
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			fail("number must be <= %v", *s.Maximum)
+		}
+	}
+
+	for _, sub := range s.AllOf {
+		sub.validate(value, pointer, depth, errs)
+	}
+	if len(s.AnyOf) > 0 && s.countMatches(s.AnyOf, value, depth) == 0 {
+		fail("value does not match any of the allowed schemas")
+	}
+	if len(s.OneOf) > 0 {
+		if matches := s.countMatches(s.OneOf, value, depth); matches != 1 {
+			fail("value must match exactly one schema, matched %d", matches)
+		}
+	}
+}
+
+func (s *JSONSchema) validateObject(object map[string]any, pointer string, depth int, errs *[]JSONSchemaError) {
+	for _, key := range s.Required {
+		if _, ok := object[key]; !ok {
+			*errs = append(*errs, JSONSchemaError{Pointer: pointer, Message: fmt.Sprintf("missing required property %q", key)})
+		}
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		childPointer := pointer + "/" + escapeJSONPointer(key)
+		if property, ok := s.Properties[key]; ok {
+			property.validate(object[key], childPointer, depth, errs)
+			continue
+		}
+		if s.AdditionalProperties != nil {
+			if s.AdditionalProperties.boolean != nil && !*s.AdditionalProperties.boolean {
+				*errs = append(*errs, JSONSchemaError{Pointer: childPointer, Message: fmt.Sprintf("property %q is not allowed", key)})
+				continue
+			}
+			s.AdditionalProperties.validate(object[key], childPointer, depth, errs)
+		}
+	}
+}
+
+func (s *JSONSchema) countMatches(schemas []*JSONSchema, value any, depth int) int {
+	matches := 0
+	for _, sub := range schemas {
+		var subErrs []JSONSchemaError
+		sub.validate(value, "", depth, &subErrs)
+		if len(subErrs) == 0 {
+			matches++
+		}
+	}
+	return matches
+}
+
+func (s *JSONSchema) resolveRef() *JSONSchema {
+	if s.root == nil {
+		return nil
+	}
+	if s.Ref == "#" {
+		return s.root
+	}
+	if name, ok := strings.CutPrefix(s.Ref, "#/$defs/"); ok {
+		return s.root.Defs[unescapeJSONPointer(name)]
+	}
+	if name, ok := strings.CutPrefix(s.Ref, "#/definitions/"); ok {
+		return s.root.Definitions[unescapeJSONPointer(name)]
+	}
+	return nil
+}
+
+func jsonTypeMatches(schemaType string, value any) bool {
+	switch schemaType {
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	default:
+		return jsonTypeName(value) == schemaType
+	}
+}
+
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func formatJSONValues(values []any) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded, _ := json.Marshal(value)
+		parts = append(parts, string(encoded))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseJSONWithPositions belgeyi çözer ve her değerin JSON Pointer yolundan satır/sütun konumuna bir indeks döndürür.
+// Belge geçersizse hatanın konumu JSONSchemaError olarak döner.
+func ParseJSONWithPositions(content []byte) (any, map[string]JSONPosition, *JSONSchemaError) {
+	var document any
+	if err := json.Unmarshal(content, &document); err != nil {
+		syntaxErr := &JSONSchemaError{Message: "invalid JSON syntax: " + err.Error()}
+		var jsonErr *json.SyntaxError
+		if errors.As(err, &jsonErr) {
+			position := OffsetPosition(content, int(jsonErr.Offset)-1)
+			syntaxErr.Line, syntaxErr.Column = position.Line, position.Column
+		}
+		return nil, nil, syntaxErr
+	}
+
+	positions := make(map[string]JSONPosition)
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var walk func(pointer string) error
+	walk = func(pointer string) error {
+		positions[pointer] = OffsetPosition(content, skipJSONSeparators(content, int(decoder.InputOffset())))
+
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		delim, ok := token.(json.Delim)
+		if !ok {
+			return nil
+		}
+
+		switch delim {
+		case '{':
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				if err := walk(pointer + "/" + escapeJSONPointer(key.(string))); err != nil {
+					return err
+				}
+			}
+		case '[':
+			for i := 0; decoder.More(); i++ {
+				if err := walk(pointer + "/" + strconv.Itoa(i)); err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err = decoder.Token() // Kapanış ayracı
+		return err
+	}
+
+	// Belge Unmarshal ile doğrulandığından yürüyüş hata vermez; verirse yalnızca konum bilgisi eksik kalır
+	_ = walk("")
+	return document, positions, nil
+}
+
+// OffsetPosition bayt ofsetini 1 tabanlı satır ve sütuna (karakter cinsinden) çevirir
+func OffsetPosition(content []byte, offset int) JSONPosition {
+	offset = max(0, min(offset, len(content)))
+	before := content[:offset]
+	lineStart := bytes.LastIndexByte(before, '\n') + 1
+	return JSONPosition{
+		Line:   bytes.Count(before, []byte{'\n'}) + 1,
+		Column: utf8.RuneCount(before[lineStart:]) + 1,
+	}
+}
+
+// skipJSONSeparators, decoder'ın henüz okumadığı boşluk, ':' ve ',' karakterlerini atlayarak değerin başlangıcını bulur
+func skipJSONSeparators(content []byte, offset int) int {
+	for offset < len(content) {
+		switch content[offset] {
+		case ' ', '\t', '\r', '\n', ':', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// JSONPointerPath, JSON Pointer'ı okunabilir "a.b[0]" biçimine çevirir. Nokta veya köşeli ayraç içeren ya da boş
+// anahtarlar iç içe yollarla karışmaması için tırnaklı yazılır (ör. "/a.b" -> ["a.b"]).
+func JSONPointerPath(pointer string) string {
+	if pointer == "" {
+		return ""
+	}
+
+	var path strings.Builder
+	for _, segment := range strings.Split(pointer[1:], "/") {
+		segment = unescapeJSONPointer(segment)
+		if _, err := strconv.Atoi(segment); err == nil {
+			path.WriteString("[" + segment + "]")
+			continue
+		}
+		if segment == "" || strings.ContainsAny(segment, ".[]\"") {
+			path.WriteString("[" + strconv.Quote(segment) + "]")
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteByte('.')
+		}
+		path.WriteString(segment)
+	}
+	return path.String()
+}
+
+func escapeJSONPointer(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
+
+func unescapeJSONPointer(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+}
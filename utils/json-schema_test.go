@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseJSONSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr string
+	}{
+		{name: "object schema", schema: `{"type":"object","properties":{"title":{"type":"string"}}}`},
+		{name: "boolean schema", schema: `true`},
+		{name: "type list", schema: `{"type":["string","null"]}`},
+		{name: "recursion through properties", schema: `{"type":"object","properties":{"child":{"$ref":"#"}}}`},
+		{name: "recursion through items", schema: `{"$defs":{"node":{"type":"array","items":{"$ref":"#/$defs/node"}}},"$ref":"#/$defs/node"}`},
+		{name: "invalid json", schema: `{"type":`, wantErr: "invalid schema"},
+		{name: "invalid type", schema: `{"type":1}`, wantErr: "type must be a string or an array of strings"},
+		{name: "invalid pattern", schema: `{"type":"string","pattern":"("}`, wantErr: "invalid schema"},
+		{name: "self reference", schema: `{"$ref":"#"}`, wantErr: "$ref cycle detected"},
+		{name: "definition self reference", schema: `{"definitions":{"a":{"$ref":"#/definitions/a"}},"$ref":"#/definitions/a"}`, wantErr: "$ref cycle detected"},
+		{name: "mutual references", schema: `{"$defs":{"a":{"$ref":"#/$defs/b"},"b":{"$ref":"#/$defs/a"}}}`, wantErr: "$ref cycle detected"},
+		{name: "reference cycle through anyOf", schema: `{"anyOf":[{"$ref":"#"}]}`, wantErr: "$ref cycle detected"},
+		{name: "reference cycle through allOf", schema: `{"$defs":{"a":{"allOf":[{"type":"object"},{"$ref":"#/$defs/a"}]}}}`, wantErr: "$ref cycle detected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseJSONSchema([]byte(tt.schema))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ParseJSONSchema() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ParseJSONSchema() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaValidate(t *testing.T) {
+	const pageSchema = `{
+		"type": "object",
+		"required": ["title"],
+		"properties": {
+			"title": {"type": "string", "minLength": 2},
+			"count": {"type": "integer", "minimum": 0},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+			"link": {"$ref": "#/$defs/link"},
+			"kind": {"enum": ["a", "b"]},
+			"a/b": {"const": true}
+		},
+		"additionalProperties": false,
+		"$defs": {
+			"link": {
+				"type": "object",
+				"required": ["href"],
+				"properties": {"href": {"type": "string", "pattern": "^https://"}}
+			}
+		}
+	}`
+	const treeSchema = `{"type":"object","properties":{"name":{"type":"string"},"child":{"$ref":"#"}}}`
+	const oneOfSchema = `{"oneOf":[{"type":"string"},{"type":"number","minimum":0},{"type":"integer"}]}`
+	const anyOfSchema = `{"anyOf":[{"type":"string"},{"type":"null"}]}`
+
+	tests := []struct {
+		name     string
+		schema   string
+		document string
+		want     []JSONSchemaError
+	}{
+		{
+			name:     "valid document",
+			schema:   pageSchema,
+			document: `{"title":"Hi","count":1,"tags":["x"],"link":{"href":"https://a"},"kind":"a","a/b":true}`,
+		},
+		{
+			name:     "positions across lines",
+			schema:   pageSchema,
+			document: "{\n  \"title\": \"H\",\n  \"count\": 1.5\n}",
+			want: []JSONSchemaError{
+				{Pointer: "/count", Line: 3, Column: 12, Message: "expected integer, got number"},
+				{Pointer: "/title", Line: 2, Column: 12, Message: "string must be at least 2 characters"},
+			},
+		},
+		{
+			name:     "columns count characters",
+			schema:   pageSchema,
+			document: `{"title":"ğ","count":"x"}`,
+			want: []JSONSchemaError{
+				{Pointer: "/count", Line: 1, Column: 22, Message: "expected integer, got string"},
+				{Pointer: "/title", Line: 1, Column: 10, Message: "string must be at least 2 characters"},
+			},
+		},
+		{
+			name:     "required and additional properties",
+			schema:   pageSchema,
+			document: `{"extra": true}`,
+			want: []JSONSchemaError{
+				{Pointer: "", Line: 1, Column: 1, Message: `missing required property "title"`},
+				{Pointer: "/extra", Line: 1, Column: 11, Message: `property "extra" is not allowed`},
+			},
+		},
+		{
+			name:     "escaped pointer",
+			schema:   pageSchema,
+			document: `{"title":"ok","a/b":false}`,
+			want: []JSONSchemaError{
+				{Pointer: "/a~1b", Line: 1, Column: 21, Message: "value must be true"},
+			},
+		},
+		{
+			name:     "definition reference",
+			schema:   pageSchema,
+			document: `{"title":"ok","link":{"href":"http://x"}}`,
+			want: []JSONSchemaError{
+				{Pointer: "/link/href", Line: 1, Column: 30, Message: `string does not match pattern "^https://"`},
+			},
+		},
+		{
+			name:     "array items",
+			schema:   pageSchema,
+			document: `{"title":"ok","tags":["a",2,"c"]}`,
+			want: []JSONSchemaError{
+				{Pointer: "/tags", Line: 1, Column: 22, Message: "array must have at most 2 items"},
+				{Pointer: "/tags/1", Line: 1, Column: 27, Message: "expected string, got number"},
+			},
+		},
+		{
+			name:     "enum",
+			schema:   pageSchema,
+			document: `{"title":"ok","kind":"c"}`,
+			want: []JSONSchemaError{
+				{Pointer: "/kind", Line: 1, Column: 22, Message: `value must be one of "a", "b"`},
+			},
+		},
+		{
+			name:     "root reference",
+			schema:   treeSchema,
+			document: `{"child":{"child":{"name":5}}}`,
+			want: []JSONSchemaError{
+				{Pointer: "/child/child/name", Line: 1, Column: 27, Message: "expected string, got number"},
+			},
+		},
+		{name: "oneOf single match", schema: oneOfSchema, document: `"x"`},
+		{
+			name:     "oneOf no match",
+			schema:   oneOfSchema,
+			document: `-1.5`,
+			want:     []JSONSchemaError{{Pointer: "", Line: 1, Column: 1, Message: "value must match exactly one schema, matched 0"}},
+		},
+		{
+			name:     "oneOf several matches",
+			schema:   oneOfSchema,
+			document: `3`,
+			want:     []JSONSchemaError{{Pointer: "", Line: 1, Column: 1, Message: "value must match exactly one schema, matched 2"}},
+		},
+		{name: "anyOf match", schema: anyOfSchema, document: `null`},
+		{
+			name:     "anyOf no match",
+			schema:   anyOfSchema,
+			document: "\n  1",
+			want:     []JSONSchemaError{{Pointer: "", Line: 2, Column: 3, Message: "value does not match any of the allowed schemas"}},
+		},
+		{
+			name:     "syntax error",
+			schema:   pageSchema,
+			document: "{\n  \"title\": }",
+			want:     []JSONSchemaError{{Line: 2, Column: 12, Message: "invalid JSON syntax: invalid character '}' looking for beginning of value"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ParseJSONSchema([]byte(tt.schema))
+			if err != nil {
+				t.Fatalf("ParseJSONSchema() error = %v", err)
+			}
+
+			got := schema.Validate([]byte(tt.document))
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
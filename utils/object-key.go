@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"fmt"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/okanay/backend-template/configs"
+	"github.com/okanay/backend-template/types"
+)
+
+// BuildObjectKey, dosya adını sanitize edip rastgele bir hash ekleyerek
+// kategori klasörü altında benzersiz bir object key üretir.
+// Örnek: uploads/general/my-document-a1b2c3d4.pdf
+// Private dosyalar public erişime kapalı önek altında saklanır: private/uploads/cv/my-cv-a1b2c3d4.pdf
+func BuildObjectKey(folderName, filename, fileCategory string, visibility types.FileVisibility) string {
+	fileExt := ""
+	dotIndex := strings.LastIndex(filename, ".")
+
+	if dotIndex != -1 {
+		fileExt = filename[dotIndex:]  // .docx
+		filename = filename[:dotIndex] // Okan-Ay---Vize
+	}
+
+	// Sadece dosya adını sanitize et
+	safeFilename := SanitizeFilename(filename)
+
+	// Rastgele hash oluştur (8 karakter)
+	hashSuffix := GenerateRandomString(8)
+
+	// Final dosya adını oluştur: orijinal-dosya-adi-ABCDEFGH.docx
+	finalFilename := fmt.Sprintf("%s-%s%s", safeFilename, hashSuffix, fileExt)
+
+	// File category'ye göre klasör yolu oluştur
+	objectKey := path.Join(folderName, configs.NormalizeFileCategory(fileCategory), finalFilename)
+	if visibility == types.FileVisibilityPrivate {
+		objectKey = path.Join(configs.FILE_PRIVATE_PREFIX, objectKey)
+	}
+	return objectKey
+}
+
+// RelocateObjectKey, mevcut bir object key'i dosya adını koruyarak başka bir kategori klasörüne taşır.
+// Örnek: uploads/general/banner-a1b2c3d4.png -> uploads/image/banner-a1b2c3d4.png
+// Hedef görünürlük private ise anahtar private önek altına alınır (veya tersi).
+func RelocateObjectKey(objectKey, fileCategory string, visibility types.FileVisibility) string {
+	objectKey = strings.TrimPrefix(objectKey, configs.FILE_PRIVATE_PREFIX+"/")
+
+	// Anahtar yapısı: <folder>/<kategori>/<dosya-adı>
+	categoryDir, filename := path.Split(objectKey)
+	folderName := path.Dir(path.Clean(categoryDir))
+	if folderName == "." {
+		folderName = ""
+	}
+
+	newKey := path.Join(folderName, configs.NormalizeFileCategory(fileCategory), filename)
+	if visibility == types.FileVisibilityPrivate {
+		newKey = path.Join(configs.FILE_PRIVATE_PREFIX, newKey)
+	}
+	return newKey
+}
+
+// ObjectKeyFromFileURL, içerikte veya profilde geçen bir dosya URL'sinden nesne anahtarını çıkarır.
+// Sorgu ve fragment kısmı atılır. URL, FILE_CDN_URL_BASE adresiyle başlıyorsa bu adres yol öneki dahil çıkarılır;
+// aksi halde sadece domain çıkarılır, böylece eski bucket adresleriyle yazılmış URL'ler de eşleşir.
+// Örnek: https://cdn.example.com/assets/uploads/image/logo-a1b2c3d4.png?w=200 -> uploads/image/logo-a1b2c3d4.png
+func ObjectKeyFromFileURL(fileURL string) string {
+	parsed, err := url.Parse(fileURL)
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	objectKey := strings.TrimPrefix(parsed.Path, "/")
+
+	base, err := url.Parse(configs.GetFileCDNURLBase())
+	if err == nil && strings.EqualFold(base.Host, parsed.Host) {
+		if prefix := strings.Trim(base.Path, "/"); prefix != "" {
+			objectKey = strings.TrimPrefix(objectKey, prefix+"/")
+		}
+	}
+	return objectKey
+}
+
+// SanitizeFilename, dosya adını güvenli hale getirir
+func SanitizeFilename(filename string) string {
+	// Boşlukları tire ile değiştir
+	sanitized := strings.ReplaceAll(filename, " ", "-")
+
+	// Sadece alfanumerik, nokta, tire ve alt çizgi karakterlerine izin ver
+	sanitized = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, sanitized)
+
+	return sanitized
+}
+
+// ContentDisposition, verilen dosya adı için RFC 6266 uyumlu bir
+// "attachment" Content-Disposition başlık değeri üretir.
+func ContentDisposition(filename string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+}
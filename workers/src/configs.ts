@@ -3,7 +3,10 @@ const BOT_PATH_REGEX = /\.(env|git|htaccess)|wp-|admin|php|sql|config|backup/i;
 
 // Sadece image/asset uzantılarına izin ver
 const ASSET_EXTENSION_REGEX =
-  /\.(jpg|jpeg|png|gif|webp|avif|svg|pdf|mp4|webm|mp3|css|js|woff|woff2|ttf|eot|ico)$/i;
+  /\.(jpg|jpeg|png|gif|webp|avif|svg|pdf|doc|docx|txt|mp4|webm|mp3|css|js|woff|woff2|ttf|eot|ico)$/i;
+
+// Private dosyalar yalnızca backend'in ürettiği imzalı URL'ler ile indirilebilir
+const PRIVATE_PATH_REGEX = /^\/private\//;
 
 const PRESETS = {
   blur: {
@@ -12,4 +15,4 @@ const PRESETS = {
   },
 };
 
-export { BOT_PATH_REGEX, ASSET_EXTENSION_REGEX, PRESETS };
+export { BOT_PATH_REGEX, ASSET_EXTENSION_REGEX, PRIVATE_PATH_REGEX, PRESETS };
@@ -1,7 +1,11 @@
 import { Hono } from "hono";
 import { logger } from "hono/logger";
 import DetailLogger from "./logger";
-import { ASSET_EXTENSION_REGEX, BOT_PATH_REGEX } from "./configs";
+import {
+  ASSET_EXTENSION_REGEX,
+  BOT_PATH_REGEX,
+  PRIVATE_PATH_REGEX,
+} from "./configs";
 
 interface Env {
   R2_ASSETS: R2Bucket;
@@ -28,6 +32,17 @@ app.get("/*", async (c) => {
     });
   }
 
+  // Private dosyalar CDN üzerinden sunulmaz
+  if (PRIVATE_PATH_REGEX.test(pathname)) {
+    return new Response("Not Found", {
+      status: 404,
+      headers: {
+        "cache-control": "no-store",
+        "content-type": "text/plain",
+      },
+    });
+  }
+
   // Invalid extension - sadece Hono logger yeterli (detay log yok)
   if (!ASSET_EXTENSION_REGEX.test(pathname)) {
     return new Response("Not Found", {